@@ -0,0 +1,262 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"math"
+)
+
+type AllPairsShortestPathMethod int
+
+const (
+	AllPairsShortestPathAutoMethod AllPairsShortestPathMethod = iota
+	AllPairsShortestPathFloydWarshall
+	AllPairsShortestPathRepeatedSSSP
+)
+
+func (m AllPairsShortestPathMethod) String() string {
+	switch m {
+	case AllPairsShortestPathAutoMethod:
+		return "auto"
+	case AllPairsShortestPathFloydWarshall:
+		return "Floyd-Warshall"
+	case AllPairsShortestPathRepeatedSSSP:
+		return "repeated SSSP"
+	default:
+		panic("invalid all-pairs shortest path method")
+	}
+}
+
+// allPairsShortestPathBatchSize is the number of sources whose path lengths the
+// repeated SSSP method computes at once.
+const allPairsShortestPathBatchSize = 64
+
+// AllPairsShortestPath computes the lengths of the shortest paths between all
+// pairs of nodes. Unreachable pairs have no entry in distance. delta is only
+// used by the repeated SSSP method, which runs delta-stepping from batches of
+// sources at once and requires non-negative weights. The automatic method
+// chooses Floyd-Warshall for graphs with negative weights, with at most 64
+// nodes, or with at least n*n/16 edges, and repeated SSSP otherwise. If
+// computeNext is true, next(i, j) is the node following i on a shortest path
+// from i to j.
+func AllPairsShortestPath[D SingleSourceShortestPathDomains](G *Graph[D], inMethod AllPairsShortestPathMethod, delta D, computeNext bool) (distance GrB.Matrix[D], next GrB.Matrix[int], method AllPairsShortestPathMethod, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	method = inMethod
+	GrB.OK(G.Check())
+	A := G.A
+	n, err := A.Nrows()
+	GrB.OK(err)
+	nvals, err := A.Nvals()
+	GrB.OK(err)
+	emin := D(0)
+	if nvals > 0 {
+		emin, err = GrB.MatrixReduce(GrB.MinMonoid[D](), A, nil)
+		GrB.OK(err)
+	}
+
+	if method == AllPairsShortestPathAutoMethod {
+		// Floyd-Warshall performs n rank-1 updates of an n-by-n matrix regardless of
+		// nvals, which is cheaper than n single-source searches for small graphs and for
+		// dense graphs, and it is the only method that supports negative weights
+		if emin < 0 || n <= 64 || float64(nvals) >= float64(n)*float64(n)/16 {
+			method = AllPairsShortestPathFloydWarshall
+		} else {
+			method = AllPairsShortestPathRepeatedSSSP
+		}
+	} else if method == AllPairsShortestPathRepeatedSSSP && emin < 0 {
+		err = errors.New("repeated SSSP requires non-negative edge weights")
+		return
+	}
+
+	distance, err = GrB.MatrixNew[D](n, n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = distance.Free()
+		}
+	}()
+
+	switch method {
+	case AllPairsShortestPathFloydWarshall:
+		z, e := GrB.VectorNew[D](n)
+		GrB.OK(e)
+		defer try(z.Free)
+		GrB.OK(GrB.VectorAssignConstant(z, nil, nil, 0, GrB.All(n), nil))
+		Z, e := z.Diag(0)
+		GrB.OK(e)
+		defer try(Z.Free)
+		minOp := GrB.Min[D]()
+		GrB.OK(GrB.MatrixEWiseAddBinaryOp(distance, nil, nil, minOp, A, Z, nil))
+
+		Ck, e := GrB.MatrixNew[D](n, 1)
+		GrB.OK(e)
+		defer try(Ck.Free)
+		Rk, e := GrB.MatrixNew[D](1, n)
+		GrB.OK(e)
+		defer try(Rk.Free)
+		minPlus := GrB.MinPlusSemiring[D]()
+		for k := range n {
+			GrB.OK(GrB.MatrixExtract(Ck, nil, nil, distance, GrB.All(n), []int{k}, nil))
+			GrB.OK(GrB.MatrixExtract(Rk, nil, nil, distance, []int{k}, GrB.All(n), nil))
+			GrB.OK(GrB.MxM(distance, nil, &minOp, minPlus, Ck, Rk, nil))
+		}
+
+		GrB.OK(z.ExtractDiag(distance, 0, nil))
+		GrB.OK(GrB.VectorSelect(z, nil, nil, GrB.Valuelt[D](), z, 0, nil))
+		nneg, e := z.Nvals()
+		GrB.OK(e)
+		if nneg > 0 {
+			err = NegativeCycle
+			return
+		}
+
+	case AllPairsShortestPathRepeatedSSSP:
+		AL, e := GrB.MatrixNew[D](n, n)
+		GrB.OK(e)
+		defer try(AL.Free)
+		GrB.OK(GrB.MatrixSelect(AL, nil, nil, GrB.Valuele[D](), A, delta, nil))
+		AH, e := GrB.MatrixNew[D](n, n)
+		GrB.OK(e)
+		defer try(AH.Free)
+		GrB.OK(GrB.MatrixSelect(AH, nil, nil, GrB.Valuegt[D](), A, delta, nil))
+		sources := make([]int, n)
+		for i := range sources {
+			sources[i] = i
+		}
+		for start := 0; start < n; start += allPairsShortestPathBatchSize {
+			batch := sources[start:min(start+allPairsShortestPathBatchSize, n)]
+			pathLength, e := deltaStepping(AL, AH, n, batch, delta, false)
+			GrB.OK(e)
+			e = GrB.MatrixSelect(pathLength, nil, nil, GrB.Valuelt[D](), pathLength, GrB.Maximum[D](), nil)
+			if e == nil {
+				e = GrB.MatrixAssign(distance, nil, nil, pathLength, batch, GrB.All(n), nil)
+			}
+			GrB.OK(pathLength.Free())
+			GrB.OK(e)
+		}
+
+	default:
+		err = errors.New("invalid all-pairs shortest path method")
+		return
+	}
+
+	if computeNext {
+		next, err = nextHop(A, distance, n)
+		GrB.OK(err)
+	}
+	return
+}
+
+// nextHop propagates the first hop of each source s along a breadth-first
+// traversal of the tight edges (u, v) with distance(s, u) + A(u, v) == distance(s, v),
+// which yields a shortest-path tree even in the presence of zero-weight cycles.
+// For floating-point weights, the sums in distance depend on the order of the
+// additions, so an edge counts as tight if it is within nextHopTolerance.
+func nextHop[D GrB.Number](A, distance GrB.Matrix[D], n int) (next GrB.Matrix[int], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	next, err = GrB.MatrixNew[int](n, n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = next.Free()
+		}
+	}()
+	ds, err := GrB.VectorNew[D](n)
+	GrB.OK(err)
+	defer try(ds.Free)
+	dsTol, err := GrB.VectorNew[D](n)
+	GrB.OK(err)
+	defer try(dsTol.Free)
+	es, err := GrB.VectorNew[bool](n)
+	GrB.OK(err)
+	defer try(es.Free)
+	q, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(q.Free)
+	w, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(w.Free)
+	visited, err := GrB.VectorNew[bool](n)
+	GrB.OK(err)
+	defer try(visited.Free)
+	T, err := GrB.MatrixNew[D](n, n)
+	GrB.OK(err)
+	defer try(T.Free)
+	E, err := GrB.MatrixNew[bool](n, n)
+	GrB.OK(err)
+	defer try(E.Free)
+
+	anyPlus := GrB.AnyPlus[D]()
+	anyLe := GrB.AnyLe[D]()
+	anyFirst := GrB.AnyFirst[int]()
+	plus := GrB.Plus[D]()
+	for s := range n {
+		GrB.OK(GrB.MatrixColExtract(ds, nil, nil, distance, GrB.All(n), s, GrB.DescT0))
+		Ds, e := ds.Diag(0)
+		GrB.OK(e)
+		e = GrB.MxM(T, nil, nil, anyPlus, Ds, A, nil)
+		GrB.OK(Ds.Free())
+		GrB.OK(e)
+		tol, e := nextHopTolerance(ds)
+		GrB.OK(e)
+		GrB.OK(GrB.VectorApplyBinaryOp2nd(dsTol, nil, nil, plus, ds, tol, nil))
+		DsTol, e := dsTol.Diag(0)
+		GrB.OK(e)
+		e = GrB.MxM(E, nil, nil, anyLe, T, DsTol, nil)
+		GrB.OK(DsTol.Free())
+		GrB.OK(e)
+		GrB.OK(GrB.MatrixSelect(E, nil, nil, GrB.Valueeq[bool](), E, true, nil))
+
+		GrB.OK(GrB.MatrixColExtract(es, nil, nil, E, GrB.All(n), s, GrB.DescT0))
+		GrB.OK(GrB.VectorApplyIndexOp(q, nil, nil, GrB.RowIndex[int, bool](), es, 0, nil))
+		GrB.OK(q.RemoveElement(s))
+		GrB.OK(GrB.VectorAssign(w, nil, nil, q, GrB.All(n), nil))
+		GrB.OK(visited.Clear())
+		GrB.OK(GrB.VectorAssignConstant(visited, q.AsMask(), nil, true, GrB.All(n), GrB.DescS))
+		GrB.OK(visited.SetElement(true, s))
+		for {
+			GrB.OK(GrB.VxM(q, &visited, nil, anyFirst, q, GrB.MatrixView[int, bool](E), GrB.DescRSC))
+			nq, e := q.Nvals()
+			GrB.OK(e)
+			if nq == 0 {
+				break
+			}
+			GrB.OK(GrB.VectorAssign(w, q.AsMask(), nil, q, GrB.All(n), GrB.DescS))
+			GrB.OK(GrB.VectorAssignConstant(visited, q.AsMask(), nil, true, GrB.All(n), GrB.DescS))
+		}
+		GrB.OK(w.SetElement(s, s))
+		GrB.OK(GrB.MatrixRowAssign(next, nil, nil, w, s, GrB.All(n), nil))
+	}
+	return
+}
+
+// nextHopTolerance returns the slack for the tight-edge test of nextHop in the row
+// of distances ds: 0 for integer weights, and a small multiple of the largest
+// distance in magnitude for floating-point weights
+func nextHopTolerance[D GrB.Number](ds GrB.Vector[D]) (tol D, err error) {
+	var eps float64
+	switch any(tol).(type) {
+	case float32:
+		eps = 1e-4
+	case float64:
+		eps = 1e-9
+	default:
+		return
+	}
+	dmin, err := GrB.VectorReduce(GrB.MinMonoid[D](), ds, nil)
+	if err != nil {
+		return
+	}
+	dmax, err := GrB.VectorReduce(GrB.MaxMonoid[D](), ds, nil)
+	if err != nil {
+		return
+	}
+	tol = D(eps * max(1, math.Abs(float64(dmin)), math.Abs(float64(dmax))))
+	return
+}
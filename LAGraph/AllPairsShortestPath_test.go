@@ -0,0 +1,198 @@
+package LAGraph_test
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func checkAPSP(distance GrB.Matrix[float64], next GrB.Matrix[int], G *LAGraph.Graph[float64]) (err error) {
+	defer GrB.CheckErrors(&err)
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	pathLength, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer func() {
+		GrB.OK(pathLength.Free())
+	}()
+	for src := range n {
+		GrB.OK(GrB.MatrixColExtract(pathLength, nil, nil, distance, GrB.All(n), src, GrB.DescT0))
+		GrB.OK(checkSSSP(pathLength, G, src))
+	}
+	for i := range n {
+		for j := range n {
+			dij, ok, e := distance.ExtractElement(i, j)
+			GrB.OK(e)
+			if !ok {
+				continue
+			}
+			length := float64(0)
+			u := i
+			for steps := 0; u != j; steps++ {
+				if steps == n {
+					return errors.New("next-hop path does not reach its destination")
+				}
+				v, ok, e := next.ExtractElement(u, j)
+				GrB.OK(e)
+				if !ok {
+					return errors.New("next hop missing")
+				}
+				w, ok, e := G.A.ExtractElement(u, v)
+				GrB.OK(e)
+				if !ok {
+					return errors.New("next hop is not an edge")
+				}
+				length += w
+				u = v
+			}
+			if math.Abs(length-dij) > 1e-5*math.Max(1, math.Abs(dij)) {
+				return errors.New("next-hop path is not a shortest path")
+			}
+		}
+	}
+	return
+}
+
+func TestAllPairsShortestPath(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, aname := range []string{"test_BF.mtx", "ldbc-directed-example.mtx", "west0067.mtx"} {
+		f, err := os.Open(filepath.Join("testdata", aname))
+		try(err)
+		A, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		try(GrB.MatrixApply(A, nil, nil, GrB.Abs[float64](), A, nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyDirected)
+		for _, method := range []LAGraph.AllPairsShortestPathMethod{
+			LAGraph.AllPairsShortestPathFloydWarshall,
+			LAGraph.AllPairsShortestPathRepeatedSSSP,
+		} {
+			distance, next, usedMethod, err := LAGraph.AllPairsShortestPath(G, method, 2, true)
+			try(err)
+			if usedMethod != method {
+				t.Errorf("expected method %v, got %v", method, usedMethod)
+			}
+			try(checkAPSP(distance, next, G))
+			try(distance.Free())
+			try(next.Free())
+		}
+		try(G.Delete())
+	}
+
+	// both methods compute the same distances on the Floyd-Warshall test matrices, which
+	// are too large to check every next-hop path
+	for _, test := range []struct {
+		aname string
+		auto  LAGraph.AllPairsShortestPathMethod
+	}{
+		{"test_FW_1000.mtx", LAGraph.AllPairsShortestPathRepeatedSSSP},
+		{"test_FW_2003.mtx", LAGraph.AllPairsShortestPathRepeatedSSSP},
+		{"test_FW_2500.mtx", LAGraph.AllPairsShortestPathRepeatedSSSP},
+	} {
+		f, err := os.Open(filepath.Join("testdata", test.aname))
+		try(err)
+		A, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		G := LAGraph.New(A, LAGraph.AdjacencyDirected)
+		sssp, _, method, err := LAGraph.AllPairsShortestPath(G, LAGraph.AllPairsShortestPathAutoMethod, 100, false)
+		try(err)
+		if method != test.auto {
+			t.Errorf("%v: expected method %v, got %v", test.aname, test.auto, method)
+		}
+		fw, _, _, err := LAGraph.AllPairsShortestPath(G, LAGraph.AllPairsShortestPathFloydWarshall, 100, false)
+		try(err)
+		ok, err := LAGraph.MatrixIsEqual(sssp, fw)
+		try(err)
+		if !ok {
+			t.Errorf("%v: Floyd-Warshall and repeated SSSP differ", test.aname)
+		}
+		n, err := A.Nrows()
+		try(err)
+		src := n / 2
+		pathLength, err := GrB.VectorNew[float64](n)
+		try(err)
+		try(GrB.MatrixColExtract(pathLength, nil, nil, fw, GrB.All(n), src, GrB.DescT0))
+		try(checkSSSP(pathLength, G, src))
+		try(pathLength.Free())
+		try(sssp.Free())
+		try(fw.Free())
+		try(G.Delete())
+	}
+
+	{
+		A, err := GrB.MatrixNew[float64](4, 4)
+		try(err)
+		try(A.Build([]int{0, 1, 2, 3}, []int{1, 2, 3, 0}, []float64{1, 2, 3, 4}, nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyDirected)
+		distance, _, method, err := LAGraph.AllPairsShortestPath(G, LAGraph.AllPairsShortestPathAutoMethod, 1, false)
+		try(err)
+		if method != LAGraph.AllPairsShortestPathFloydWarshall {
+			t.Errorf("expected Floyd-Warshall for a small graph, got %v", method)
+		}
+		try(distance.Free())
+		if _, _, _, err = LAGraph.AllPairsShortestPath(G, LAGraph.AllPairsShortestPathRepeatedSSSP, 0, false); err == nil {
+			t.Error("expected an error for repeated SSSP with delta 0")
+		}
+		try(G.Delete())
+	}
+
+	{
+		A, err := GrB.MatrixNew[int](3, 3)
+		try(err)
+		try(A.Build([]int{0, 1, 2}, []int{1, 2, 0}, []int{1, -2, 0}, nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyDirected)
+		_, _, method, err := LAGraph.AllPairsShortestPath(G, LAGraph.AllPairsShortestPathAutoMethod, 1, false)
+		if method != LAGraph.AllPairsShortestPathFloydWarshall {
+			t.Errorf("expected Floyd-Warshall for negative weights, got %v", method)
+		}
+		if !errors.Is(err, LAGraph.NegativeCycle) {
+			t.Errorf("expected NegativeCycle, got %v", err)
+		}
+		if _, _, _, err = LAGraph.AllPairsShortestPath(G, LAGraph.AllPairsShortestPathRepeatedSSSP, 1, false); err == nil {
+			t.Error("expected an error for repeated SSSP with negative weights")
+		}
+		try(G.Delete())
+	}
+
+	// fractional weights, whose sums depend on the order of the additions
+	{
+		rng := rand.New(rand.NewSource(7))
+		const n = 100
+		var I, J []int
+		var X []float64
+		for range 6 * n {
+			I = append(I, rng.Intn(n))
+			J = append(J, rng.Intn(n))
+			X = append(X, 0.1+rng.Float64()/3)
+		}
+		A, err := GrB.MatrixNew[float64](n, n)
+		try(err)
+		first := GrB.First[float64, float64]()
+		try(A.Build(I, J, X, &first))
+		G := LAGraph.New(A, LAGraph.AdjacencyDirected)
+		for _, method := range []LAGraph.AllPairsShortestPathMethod{
+			LAGraph.AllPairsShortestPathFloydWarshall,
+			LAGraph.AllPairsShortestPathRepeatedSSSP,
+		} {
+			distance, next, _, err := LAGraph.AllPairsShortestPath(G, method, 0.25, true)
+			try(err)
+			if err := checkAPSP(distance, next, G); err != nil {
+				t.Errorf("fractional weights, %v: %v", method, err)
+			}
+			try(distance.Free())
+			try(next.Free())
+		}
+		try(G.Delete())
+	}
+}
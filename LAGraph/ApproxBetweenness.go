@@ -0,0 +1,70 @@
+package LAGraph
+
+import (
+	"github.com/intel/forGraphBLASGo/GrB"
+	"math/rand"
+)
+
+// approxBetweennessBatchSize is the number of sources of each call to Betweenness,
+// which bounds the size of its ns×n matrices.
+const approxBetweennessBatchSize = 32
+
+// ApproxBetweenness estimates the betweenness centrality of the nodes of G from
+// random sources, chosen with the given seed and processed in batches. After each
+// batch, the accumulated centrality is scaled by n divided by the number of sources
+// so far, and the iteration stops when the sum of the absolute changes of this
+// estimate is at most epsilon times its sum, or when all nodes have been used as
+// sources, in which case the result is exact. nsources is the number of sources used.
+func (G *Graph[D]) ApproxBetweenness(epsilon float64, seed int64) (centrality GrB.Vector[float64], nsources int, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	GrB.OK(G.Check())
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	sources := rand.New(rand.NewSource(seed)).Perm(n)
+
+	total, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer try(total.Free)
+	GrB.OK(GrB.VectorAssignConstant(total, nil, nil, 0, GrB.All(n), nil))
+	centrality, err = GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = centrality.Free()
+		}
+	}()
+	GrB.OK(GrB.VectorAssignConstant(centrality, nil, nil, 0, GrB.All(n), nil))
+	previous, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer try(previous.Free)
+
+	plus := GrB.Plus[float64]()
+	plusMonoid := GrB.PlusMonoid[float64]()
+	for nsources < n {
+		batch := sources[nsources:min(nsources+approxBetweennessBatchSize, n)]
+		update, e := G.Betweenness(batch)
+		GrB.OK(e)
+		GrB.OK(GrB.VectorAssign(total, nil, &plus, update, GrB.All(n), nil))
+		GrB.OK(update.Free())
+		nsources += len(batch)
+
+		GrB.OK(GrB.VectorAssign(previous, nil, nil, centrality, GrB.All(n), nil))
+		GrB.OK(GrB.VectorApplyBinaryOp2nd(centrality, nil, nil, GrB.Times[float64](), total, float64(n)/float64(nsources), nil))
+		if nsources == len(batch) {
+			continue
+		}
+		sum, e := GrB.VectorReduce(plusMonoid, centrality, nil)
+		GrB.OK(e)
+		GrB.OK(GrB.VectorEWiseAddBinaryOp(previous, nil, nil, GrB.Minus[float64](), centrality, previous, nil))
+		GrB.OK(GrB.VectorApply(previous, nil, nil, GrB.Abs[float64](), previous, nil))
+		change, e := GrB.VectorReduce(plusMonoid, previous, nil)
+		GrB.OK(e)
+		if change <= epsilon*sum {
+			break
+		}
+	}
+	return
+}
@@ -0,0 +1,93 @@
+package LAGraph_test
+
+import (
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestApproxBetweenness(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, test := range []struct {
+		aname string
+		kind  LAGraph.Kind
+	}{
+		{"karate.mtx", LAGraph.AdjacencyUndirected},
+		{"west0067.mtx", LAGraph.AdjacencyDirected},
+	} {
+		f, err := os.Open(filepath.Join("testdata", test.aname))
+		try(err)
+		A, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		n, err := A.Nrows()
+		try(err)
+		G := LAGraph.New(A, test.kind)
+		if test.kind == LAGraph.AdjacencyDirected {
+			_, err = G.CachedAT()
+			try(err)
+		}
+
+		all := make([]int, n)
+		for i := range all {
+			all[i] = i
+		}
+		exact, err := G.Betweenness(all)
+		try(err)
+		var I []int
+		var expected []float64
+		try(exact.ExtractTuples(&I, &expected))
+		try(exact.Free())
+		expectedSum := 0.0
+		for _, x := range expected {
+			expectedSum += x
+		}
+
+		// an epsilon of 0 uses all nodes as sources
+		centrality, nsources, err := G.ApproxBetweenness(0, 1)
+		try(err)
+		if nsources != n {
+			t.Errorf("%v: %v sources, expected %v", test.aname, nsources, n)
+		}
+		checkCentrality(t, test.aname+" betweenness", centrality, expected)
+		try(centrality.Free())
+
+		centrality, nsources, err = G.ApproxBetweenness(0.05, 1)
+		try(err)
+		if nsources < 1 || nsources > n {
+			t.Errorf("%v: unexpected number of sources %v", test.aname, nsources)
+		}
+		var X []float64
+		try(centrality.ExtractTuples(&I, &X))
+		if len(X) != n {
+			t.Errorf("%v: %v entries, expected %v", test.aname, len(X), n)
+		} else {
+			diff := 0.0
+			for i := range n {
+				diff += math.Abs(X[i] - expected[i])
+			}
+			if diff > 0.5*expectedSum {
+				t.Errorf("%v: the estimate is off by %v, with a total of %v", test.aname, diff, expectedSum)
+			}
+		}
+		again, nsources2, err := G.ApproxBetweenness(0.05, 1)
+		try(err)
+		ok, err := LAGraph.VectorIsEqual(centrality, again)
+		try(err)
+		if !ok || nsources != nsources2 {
+			t.Errorf("%v: the same seed gives different results", test.aname)
+		}
+		for _, x := range []GrB.Vector[float64]{centrality, again} {
+			try(x.Free())
+		}
+		try(G.Delete())
+	}
+}
@@ -0,0 +1,80 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+)
+
+var NegativeCycle = errors.New("negative-weight cycle reachable from source")
+
+func BellmanFord[D SingleSourceShortestPathDomains](G *Graph[D], source int) (pathLength GrB.Vector[D], parent GrB.Vector[int], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	GrB.OK(G.Check())
+	A := G.A
+	n, err := A.Nrows()
+	GrB.OK(err)
+	if source < 0 || source >= n {
+		err = errors.New("invalid source node")
+		return
+	}
+
+	t, err := GrB.VectorNew[D](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = t.Free()
+		}
+	}()
+	tReq, err := GrB.VectorNew[D](n)
+	GrB.OK(err)
+	defer try(tReq.Free)
+	tless, err := GrB.VectorNew[bool](n)
+	GrB.OK(err)
+	defer try(tless.Free)
+
+	minPlus := GrB.MinPlusSemiring[D]()
+	lessThan := GrB.Lt[D]()
+	ne := GrB.Valuene[bool]()
+
+	GrB.OK(t.SetElement(0, source))
+	for iter := 0; ; iter++ {
+		GrB.OK(GrB.VxM(tReq, nil, nil, minPlus, t, A, nil))
+		GrB.OK(GrB.VectorEWiseMultBinaryOp(tless, nil, nil, lessThan, tReq, t, nil))
+		GrB.OK(GrB.VectorApply(tless, t.AsMask(), nil, GrB.One[bool](), GrB.VectorView[bool, D](tReq), GrB.DescSC))
+		GrB.OK(GrB.VectorSelect(tless, nil, nil, ne, tless, false, nil))
+		nless, e := tless.Nvals()
+		GrB.OK(e)
+		if nless == 0 {
+			break
+		}
+		if iter >= n-1 {
+			err = NegativeCycle
+			return
+		}
+		GrB.OK(GrB.VectorAssign(t, &tless, nil, tReq, GrB.All(n), GrB.DescS))
+	}
+
+	// tight edges (u, v) with t(u) + A(u, v) == t(v) form the shortest-path subgraph
+	Dt, err := t.Diag(0)
+	GrB.OK(err)
+	defer try(Dt.Free)
+	T, err := GrB.MatrixNew[D](n, n)
+	GrB.OK(err)
+	defer try(T.Free)
+	GrB.OK(GrB.MxM(T, nil, nil, GrB.AnyPlus[D](), Dt, A, nil))
+	E, err := GrB.MatrixNew[bool](n, n)
+	GrB.OK(err)
+	S := New(E, AdjacencyDirected)
+	defer try(S.Delete)
+	GrB.OK(GrB.MxM(E, nil, nil, GrB.AnyEq[D](), T, Dt, nil))
+	GrB.OK(GrB.MatrixSelect(E, nil, nil, GrB.Valueeq[bool](), E, true, nil))
+
+	_, parent, err = S.BreadthFirstSearch(source, false, true)
+	GrB.OK(err)
+
+	pathLength = t
+	return
+}
@@ -0,0 +1,102 @@
+package LAGraph_test
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func checkBellmanFordParent[D LAGraph.SingleSourceShortestPathDomains](pathLength GrB.Vector[D], parent GrB.Vector[int], G *LAGraph.Graph[D], src int) (err error) {
+	defer GrB.CheckErrors(&err)
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	for v := range n {
+		dv, reachable, e := pathLength.ExtractElement(v)
+		GrB.OK(e)
+		p, ok, e := parent.ExtractElement(v)
+		GrB.OK(e)
+		if reachable != ok {
+			return errors.New("parent and path length differ in reach")
+		}
+		if !reachable {
+			continue
+		}
+		if v == src {
+			if p != src {
+				return errors.New("invalid parent of source")
+			}
+			continue
+		}
+		du, ok, e := pathLength.ExtractElement(p)
+		GrB.OK(e)
+		if !ok {
+			return errors.New("parent not reachable")
+		}
+		w, ok, e := G.A.ExtractElement(p, v)
+		GrB.OK(e)
+		if !ok || du+w != dv {
+			return errors.New("parent edge not on a shortest path")
+		}
+	}
+	return
+}
+
+func TestBellmanFord(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, aname := range []string{"test_BF.mtx", "ldbc-directed-example.mtx", "west0067.mtx"} {
+		f, err := os.Open(filepath.Join("testdata", aname))
+		try(err)
+		A, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		try(GrB.MatrixApply(A, nil, nil, GrB.Abs[float64](), A, nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyDirected)
+		n, err := A.Nrows()
+		try(err)
+		for src := 0; src < n; src += n/4 + 1 {
+			pathLength, parent, err := LAGraph.BellmanFord(G, src)
+			try(err)
+			try(checkSSSP(pathLength, G, src))
+			try(checkBellmanFordParent(pathLength, parent, G, src))
+			try(pathLength.Free())
+			try(parent.Free())
+		}
+		try(G.Delete())
+	}
+
+	{
+		// 0 -> 1 (4), 0 -> 2 (5), 2 -> 1 (-3), 1 -> 3 (2)
+		A, err := GrB.MatrixNew[int](4, 4)
+		try(err)
+		try(A.Build([]int{0, 0, 2, 1}, []int{1, 2, 1, 3}, []int{4, 5, -3, 2}, nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyDirected)
+		pathLength, parent, err := LAGraph.BellmanFord(G, 0)
+		try(err)
+		for i, expected := range []int{0, 2, 5, 4} {
+			d, ok, err := pathLength.ExtractElement(i)
+			try(err)
+			if !ok || d != expected {
+				t.Errorf("node %v: expected path length %v, got %v", i, expected, d)
+			}
+		}
+		try(checkBellmanFordParent(pathLength, parent, G, 0))
+		try(pathLength.Free())
+		try(parent.Free())
+
+		// adding 3 -> 2 (-5) closes the negative cycle 2 -> 1 -> 3 -> 2
+		try(G.A.SetElement(-5, 3, 2))
+		_, _, err = LAGraph.BellmanFord(G, 0)
+		if !errors.Is(err, LAGraph.NegativeCycle) {
+			t.Errorf("expected NegativeCycle, got %v", err)
+		}
+		try(G.Delete())
+	}
+}
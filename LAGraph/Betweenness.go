@@ -6,6 +6,32 @@ import (
 )
 
 func (G *Graph[D]) Betweenness(sources []int) (centrality GrB.Vector[float64], err error) {
+	centrality, _, err = G.betweenness(sources, false)
+	return
+}
+
+// EdgeBetweenness computes the betweenness centrality of the edges of G from the shortest
+// paths from the given sources. The result has the same pattern as G.A. For an undirected
+// graph, edges(i, j) and edges(j, i) both count the shortest paths through the edge in
+// either direction, like the shortest paths through a node are counted by Betweenness.
+func (G *Graph[D]) EdgeBetweenness(sources []int) (edges GrB.Matrix[float64], err error) {
+	defer GrB.CheckErrors(&err)
+	centrality, edges, err := G.betweenness(sources, true)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = edges.Free()
+		}
+	}()
+	GrB.OK(centrality.Free())
+	if G.Kind == AdjacencyUndirected {
+		plus := GrB.Plus[float64]()
+		GrB.OK(GrB.MatrixApply(edges, nil, &plus, GrB.Identity[float64](), edges, GrB.DescT0))
+	}
+	return
+}
+
+func (G *Graph[D]) betweenness(sources []int, computeEdges bool) (centrality GrB.Vector[float64], edges GrB.Matrix[float64], err error) {
 	defer GrB.CheckErrors(&err)
 
 	try := func(f func() error) {
@@ -92,6 +118,23 @@ func (G *Graph[D]) Betweenness(sources []int) (centrality GrB.Vector[float64], e
 
 	GrB.OK(frontier.Free())
 
+	// the dependency of source s on an edge (u, v) is paths(s, u) * W(s, v), where
+	// W(s, v) is computed for the depth of v and u is one level closer to s
+	var P GrB.Matrix[float64]
+	if computeEdges {
+		edges, err = GrB.MatrixNew[float64](n, n)
+		GrB.OK(err)
+		defer func() {
+			if err != nil {
+				_ = edges.Free()
+			}
+		}()
+		GrB.OK(GrB.MatrixAssignConstant(edges, A.AsMask(), nil, 0, GrB.All(n), GrB.All(n), GrB.DescS))
+		P, err = GrB.MatrixNew[float64](ns, n)
+		GrB.OK(err)
+		defer try(P.Free)
+	}
+
 	bcUpdate, err := GrB.MatrixNew[float64](ns, n)
 	GrB.OK(err)
 	defer try(bcUpdate.Free)
@@ -103,6 +146,10 @@ func (G *Graph[D]) Betweenness(sources []int) (centrality GrB.Vector[float64], e
 
 	for i := depth - 1; i > 0; i-- {
 		GrB.OK(GrB.MatrixEWiseMultBinaryOp(W, &S[i], nil, GrB.Div[float64](), bcUpdate, paths, GrB.DescRS))
+		if computeEdges {
+			GrB.OK(GrB.MatrixAssign(P, &S[i-1], nil, paths, GrB.All(ns), GrB.All(n), GrB.DescRS))
+			GrB.OK(GrB.MxM(edges, A.AsMask(), &plus, GrB.PlusTimesSemiring[float64](), P, W, GrB.DescST0))
+		}
 		wsize, e := W.Nvals()
 		GrB.OK(e)
 		ssize, e := S[i-1].Nvals()
@@ -122,6 +169,16 @@ func (G *Graph[D]) Betweenness(sources []int) (centrality GrB.Vector[float64], e
 		GrB.OK(GrB.MatrixEWiseMultBinaryOp(bcUpdate, nil, &plus, GrB.Times[float64](), W, paths, nil))
 	}
 
+	if computeEdges && depth > 0 {
+		// the edges from the sources themselves
+		GrB.OK(GrB.MatrixEWiseMultBinaryOp(W, &S[0], nil, GrB.Div[float64](), bcUpdate, paths, GrB.DescRS))
+		GrB.OK(P.Clear())
+		for i, src := range sources {
+			GrB.OK(P.SetElement(1, i, src))
+		}
+		GrB.OK(GrB.MxM(edges, A.AsMask(), &plus, GrB.PlusTimesSemiring[float64](), P, W, GrB.DescST0))
+	}
+
 	centrality, err = GrB.VectorNew[float64](n)
 	GrB.OK(err)
 	GrB.OK(GrB.VectorAssignConstant(centrality, nil, nil, float64(-ns), GrB.All(n), nil))
@@ -0,0 +1,271 @@
+package Binary
+
+import (
+	"encoding/binary"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"io"
+	"math/bits"
+	"unsafe"
+)
+
+const headerSize = 512
+
+var typeCodes = map[GrB.Type]int32{
+	GrB.Bool:       0,
+	GrB.Int8:       1,
+	GrB.Int16:      2,
+	GrB.Int32:      3,
+	GrB.Int64:      4,
+	GrB.Uint8:      5,
+	GrB.Uint16:     6,
+	GrB.Uint32:     7,
+	GrB.Uint64:     8,
+	GrB.Float32:    9,
+	GrB.Float64:    10,
+	GrB.Complex64:  11,
+	GrB.Complex128: 12,
+}
+
+var typeNames = [...]string{
+	"bool",
+	"int8_t",
+	"int16_t",
+	"int32_t",
+	"int64_t",
+	"uint8_t",
+	"uint16_t",
+	"uint32_t",
+	"uint64_t",
+	"float",
+	"double",
+	"float complex",
+	"double complex",
+}
+
+func init() {
+	if unsafe.Sizeof(0) == 4 {
+		typeCodes[GrB.Int] = typeCodes[GrB.Int32]
+		typeCodes[GrB.Uint] = typeCodes[GrB.Uint32]
+	} else {
+		typeCodes[GrB.Int] = typeCodes[GrB.Int64]
+		typeCodes[GrB.Uint] = typeCodes[GrB.Uint64]
+	}
+}
+
+func BinRead[D GrB.Predefined | GrB.Complex](r io.Reader) (matrix GrB.Matrix[D], err error) {
+	defer GrB.CheckErrors(&err)
+
+	_, err = io.CopyN(io.Discard, r, headerSize)
+	GrB.OK(err)
+
+	var fmt int32 = -999
+	var kind, typecode int32
+	var hyper float64 = -999
+	var nrows, ncols, nvec, nvals, typesize uint64
+	var nonempty int64
+	GrB.OK(binary.Read(r, binary.LittleEndian, &fmt))
+	GrB.OK(binary.Read(r, binary.LittleEndian, &kind))
+	GrB.OK(binary.Read(r, binary.LittleEndian, &hyper))
+	GrB.OK(binary.Read(r, binary.LittleEndian, &nrows))
+	GrB.OK(binary.Read(r, binary.LittleEndian, &ncols))
+	GrB.OK(binary.Read(r, binary.LittleEndian, &nonempty))
+	GrB.OK(binary.Read(r, binary.LittleEndian, &nvec))
+	GrB.OK(binary.Read(r, binary.LittleEndian, &nvals))
+	GrB.OK(binary.Read(r, binary.LittleEndian, &typecode))
+	GrB.OK(binary.Read(r, binary.LittleEndian, &typesize))
+
+	// reject a malformed header before allocating any memory for the content
+	if layout := GrB.Layout(fmt); layout != GrB.ByRow && layout != GrB.ByCol {
+		err = GrB.InvalidObject
+		return
+	}
+	if hi, lo := bits.Mul64(nrows, ncols); (hi == 0 && nvals > lo) || nvec > max(nrows, ncols) {
+		err = GrB.InvalidObject
+		return
+	}
+
+	iso := false
+	if kind > 100 {
+		iso = true
+		kind -= 100
+	}
+
+	isHyper := kind == 1
+	isSparse := kind == 0 || kind == int32(GrB.Sparse)
+	isBitmap := kind == int32(GrB.Bitmap)
+	isFull := kind == int32(GrB.Full)
+
+	switch typecode {
+	case 0:
+		m, e := GrB.MatrixNew[bool](int(nrows), int(ncols))
+		GrB.OK(e)
+		matrix = GrB.MatrixView[D, bool](m)
+	case 1:
+		m, e := GrB.MatrixNew[int8](int(nrows), int(ncols))
+		GrB.OK(e)
+		matrix = GrB.MatrixView[D, int8](m)
+	case 2:
+		m, e := GrB.MatrixNew[int16](int(nrows), int(ncols))
+		GrB.OK(e)
+		matrix = GrB.MatrixView[D, int16](m)
+	case 3:
+		m, e := GrB.MatrixNew[int32](int(nrows), int(ncols))
+		GrB.OK(e)
+		matrix = GrB.MatrixView[D, int32](m)
+	case 4:
+		m, e := GrB.MatrixNew[int64](int(nrows), int(ncols))
+		GrB.OK(e)
+		matrix = GrB.MatrixView[D, int64](m)
+	case 5:
+		m, e := GrB.MatrixNew[uint8](int(nrows), int(ncols))
+		GrB.OK(e)
+		matrix = GrB.MatrixView[D, uint8](m)
+	case 6:
+		m, e := GrB.MatrixNew[uint16](int(nrows), int(ncols))
+		GrB.OK(e)
+		matrix = GrB.MatrixView[D, uint16](m)
+	case 7:
+		m, e := GrB.MatrixNew[uint32](int(nrows), int(ncols))
+		GrB.OK(e)
+		matrix = GrB.MatrixView[D, uint32](m)
+	case 8:
+		m, e := GrB.MatrixNew[uint64](int(nrows), int(ncols))
+		GrB.OK(e)
+		matrix = GrB.MatrixView[D, uint64](m)
+	case 9:
+		m, e := GrB.MatrixNew[float32](int(nrows), int(ncols))
+		GrB.OK(e)
+		matrix = GrB.MatrixView[D, float32](m)
+	case 10:
+		m, e := GrB.MatrixNew[float64](int(nrows), int(ncols))
+		GrB.OK(e)
+		matrix = GrB.MatrixView[D, float64](m)
+	case 11:
+		m, e := GrB.MatrixNew[complex64](int(nrows), int(ncols))
+		GrB.OK(e)
+		matrix = GrB.MatrixView[D, complex64](m)
+	case 12:
+		m, e := GrB.MatrixNew[complex128](int(nrows), int(ncols))
+		GrB.OK(e)
+		matrix = GrB.MatrixView[D, complex128](m)
+	default:
+		err = GrB.NotImplemented
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = matrix.Free()
+		}
+	}()
+
+	typ, _, err := matrix.Type()
+	GrB.OK(err)
+	size, err := typ.Size()
+	GrB.OK(err)
+	if uint64(size) != typesize {
+		err = GrB.InvalidObject
+		return
+	}
+
+	GrB.OK(matrix.SetHyperSwitch(hyper))
+
+	var ap, ai, ah, ab, ax GrB.SystemSlice[byte]
+	var axLen int
+
+	indexSize := int(unsafe.Sizeof(uint64(0)))
+	switch {
+	case isHyper:
+		apLen := int(nvec + 1)
+		ahLen := int(nvec)
+		aiLen := int(nvals)
+		axLen = int(nvals)
+		ap = GrB.MakeSystemSlice[byte](apLen * indexSize)
+		ah = GrB.MakeSystemSlice[byte](ahLen * indexSize)
+		ai = GrB.MakeSystemSlice[byte](aiLen * indexSize)
+		defer func() {
+			if err != nil {
+				ai.Free()
+				ah.Free()
+				ap.Free()
+			}
+		}()
+	case isSparse:
+		apLen := int(nvec + 1)
+		aiLen := int(nvals)
+		axLen = int(nvals)
+		ap = GrB.MakeSystemSlice[byte](apLen * indexSize)
+		ai = GrB.MakeSystemSlice[byte](aiLen * indexSize)
+		defer func() {
+			if err != nil {
+				ai.Free()
+				ap.Free()
+			}
+		}()
+	case isBitmap:
+		axLen = int(nrows * ncols)
+		ab = GrB.MakeSystemSlice[byte](int(nrows * ncols))
+		defer func() {
+			if err != nil {
+				ab.Free()
+			}
+		}()
+	case isFull:
+		axLen = int(nrows * ncols)
+	default:
+		err = GrB.InvalidObject
+		return
+	}
+	var axSize int
+	if iso {
+		axSize = int(typesize)
+	} else {
+		axSize = axLen * int(typesize)
+	}
+	ax = GrB.MakeSystemSlice[byte](axSize)
+	defer func() {
+		if err != nil {
+			ax.Free()
+		}
+	}()
+
+	{
+		tryRead := func(bytes GrB.SystemSlice[byte]) {
+			_, err = io.ReadFull(r, bytes.UnsafeSlice())
+			GrB.OK(err)
+		}
+		switch {
+		case isHyper:
+			tryRead(ap)
+			tryRead(ah)
+			tryRead(ai)
+		case isSparse:
+			tryRead(ap)
+			tryRead(ai)
+		case isBitmap:
+			tryRead(ab)
+		}
+		tryRead(ax)
+	}
+
+	switch fmt := GrB.Layout(fmt); {
+	case fmt == GrB.ByCol && isHyper:
+		err = matrix.PackHyperCSCBytes(&ap, &ah, &ai, &ax, iso, int(nvec), false, nil)
+	case fmt == GrB.ByRow && isHyper:
+		err = matrix.PackHyperCSRBytes(&ap, &ah, &ai, &ax, iso, int(nvec), false, nil)
+	case fmt == GrB.ByCol && isSparse:
+		err = matrix.PackCSCBytes(&ap, &ai, &ax, iso, false, nil)
+	case fmt == GrB.ByRow && isSparse:
+		err = matrix.PackCSRBytes(&ap, &ai, &ax, iso, false, nil)
+	case fmt == GrB.ByCol && isBitmap:
+		err = matrix.PackBitmapCBytes(&ab, &ax, iso, int(nvals), nil)
+	case fmt == GrB.ByRow && isBitmap:
+		err = matrix.PackBitmapRBytes(&ab, &ax, iso, int(nvals), nil)
+	case fmt == GrB.ByCol && isFull:
+		err = matrix.PackFullCBytes(&ax, iso, nil)
+	case fmt == GrB.ByRow && isFull:
+		err = matrix.PackFullRBytes(&ax, iso, nil)
+	default:
+		err = GrB.InvalidObject
+	}
+	return
+}
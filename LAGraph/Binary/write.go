@@ -0,0 +1,161 @@
+package Binary
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"io"
+	"unsafe"
+)
+
+func BinWrite[D GrB.Predefined | GrB.Complex](w io.Writer, A GrB.Matrix[D]) (err error) {
+	defer GrB.CheckErrors(&err)
+
+	GrB.OK(A.Wait(GrB.Materialize))
+	nrows, ncols, err := A.Size()
+	GrB.OK(err)
+	nvals, err := A.Nvals()
+	GrB.OK(err)
+	typ, ok, err := A.Type()
+	GrB.OK(err)
+	if !ok {
+		err = errors.New("matrix type unknown")
+		return
+	}
+	typecode, ok := typeCodes[typ]
+	if !ok {
+		err = GrB.NotImplemented
+		return
+	}
+	typesize, err := typ.Size()
+	GrB.OK(err)
+	layout, err := A.GetLayout()
+	GrB.OK(err)
+	sparsity, err := A.GetSparsityStatus()
+	GrB.OK(err)
+	hyper, err := A.GetHyperSwitch()
+	GrB.OK(err)
+
+	var ap, ah, ai, ab, ax GrB.SystemSlice[byte]
+	var iso bool
+	var nvec int
+	switch {
+	case layout == GrB.ByCol && sparsity == GrB.Hypersparse:
+		ap, ah, ai, ax, iso, nvec, _, err = A.UnpackHyperCSCBytes(false, nil)
+		GrB.OK(err)
+		defer func() {
+			GrB.OK(A.PackHyperCSCBytes(&ap, &ah, &ai, &ax, iso, nvec, false, nil))
+		}()
+	case layout == GrB.ByRow && sparsity == GrB.Hypersparse:
+		ap, ah, ai, ax, iso, nvec, _, err = A.UnpackHyperCSRBytes(false, nil)
+		GrB.OK(err)
+		defer func() {
+			GrB.OK(A.PackHyperCSRBytes(&ap, &ah, &ai, &ax, iso, nvec, false, nil))
+		}()
+	case layout == GrB.ByCol && sparsity == GrB.Sparse:
+		ap, ai, ax, iso, _, err = A.UnpackCSCBytes(false, nil)
+		GrB.OK(err)
+		defer func() {
+			GrB.OK(A.PackCSCBytes(&ap, &ai, &ax, iso, false, nil))
+		}()
+	case layout == GrB.ByRow && sparsity == GrB.Sparse:
+		ap, ai, ax, iso, _, err = A.UnpackCSRBytes(false, nil)
+		GrB.OK(err)
+		defer func() {
+			GrB.OK(A.PackCSRBytes(&ap, &ai, &ax, iso, false, nil))
+		}()
+	case layout == GrB.ByCol && sparsity == GrB.Bitmap:
+		ab, ax, iso, nvals, err = A.UnpackBitmapCBytes(nil)
+		GrB.OK(err)
+		defer func() {
+			GrB.OK(A.PackBitmapCBytes(&ab, &ax, iso, nvals, nil))
+		}()
+	case layout == GrB.ByRow && sparsity == GrB.Bitmap:
+		ab, ax, iso, nvals, err = A.UnpackBitmapRBytes(nil)
+		GrB.OK(err)
+		defer func() {
+			GrB.OK(A.PackBitmapRBytes(&ab, &ax, iso, nvals, nil))
+		}()
+	case layout == GrB.ByCol && sparsity == GrB.Full:
+		ax, iso, err = A.UnpackFullCBytes(nil)
+		GrB.OK(err)
+		defer func() {
+			GrB.OK(A.PackFullCBytes(&ax, iso, nil))
+		}()
+	case layout == GrB.ByRow && sparsity == GrB.Full:
+		ax, iso, err = A.UnpackFullRBytes(nil)
+		GrB.OK(err)
+		defer func() {
+			GrB.OK(A.PackFullRBytes(&ax, iso, nil))
+		}()
+	default:
+		panic("unreachable code")
+	}
+	if sparsity != GrB.Hypersparse {
+		if layout == GrB.ByRow {
+			nvec = nrows
+		} else {
+			nvec = ncols
+		}
+	}
+
+	kind := int32(sparsity)
+	if iso {
+		kind += 100
+	}
+
+	indexSize := int(unsafe.Sizeof(uint64(0)))
+	var axLen int
+	switch sparsity {
+	case GrB.Hypersparse, GrB.Sparse:
+		axLen = nvals
+	default:
+		axLen = nrows * ncols
+	}
+	if iso {
+		axLen = 1
+	}
+
+	header := make([]byte, headerSize)
+	copy(header, fmt.Sprintf(
+		"SuiteSparse:GraphBLAS matrix\nv%v.%v.%v\nnrows:  %v\nncols:  %v\nnvec:   %v\nnvals:  %v\nformat: %v %v\nsize:   %v\ntype:   %v\niso:    %v\n",
+		GrB.SuiteSparseImplementationMajor,
+		GrB.SuiteSparseImplementationMinor,
+		GrB.SuiteSparseImplementationSub,
+		nrows, ncols, nvec, nvals, sparsity, layout, typesize, typeNames[typecode], iso,
+	))
+
+	bw := bufio.NewWriter(w)
+	_, err = bw.Write(header)
+	GrB.OK(err)
+	GrB.OK(binary.Write(bw, binary.LittleEndian, int32(layout)))
+	GrB.OK(binary.Write(bw, binary.LittleEndian, kind))
+	GrB.OK(binary.Write(bw, binary.LittleEndian, hyper))
+	GrB.OK(binary.Write(bw, binary.LittleEndian, uint64(nrows)))
+	GrB.OK(binary.Write(bw, binary.LittleEndian, uint64(ncols)))
+	GrB.OK(binary.Write(bw, binary.LittleEndian, int64(-1)))
+	GrB.OK(binary.Write(bw, binary.LittleEndian, uint64(nvec)))
+	GrB.OK(binary.Write(bw, binary.LittleEndian, uint64(nvals)))
+	GrB.OK(binary.Write(bw, binary.LittleEndian, typecode))
+	GrB.OK(binary.Write(bw, binary.LittleEndian, uint64(typesize)))
+
+	tryWrite := func(bytes GrB.SystemSlice[byte], size int) {
+		_, err = bw.Write(bytes.UnsafeSlice()[:size])
+		GrB.OK(err)
+	}
+	switch sparsity {
+	case GrB.Hypersparse:
+		tryWrite(ap, (nvec+1)*indexSize)
+		tryWrite(ah, nvec*indexSize)
+		tryWrite(ai, nvals*indexSize)
+	case GrB.Sparse:
+		tryWrite(ap, (nvec+1)*indexSize)
+		tryWrite(ai, nvals*indexSize)
+	case GrB.Bitmap:
+		tryWrite(ab, nrows*ncols)
+	}
+	tryWrite(ax, axLen*typesize)
+	return bw.Flush()
+}
@@ -0,0 +1,135 @@
+package LAGraph_test
+
+import (
+	"bytes"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/Binary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBinary(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, aname := range []string{"west0067.mtx", "LFAT5_hypersparse.mtx", "full.mtx", "karate.mtx", "empty.mtx"} {
+		for _, test := range []struct {
+			name     string
+			sparsity GrB.Sparsity
+			iso      bool
+		}{
+			{"sparse", GrB.Sparse, false},
+			{"hypersparse", GrB.Hypersparse, false},
+			{"bitmap", GrB.Bitmap, false},
+			{"full", GrB.Full, false},
+			{"iso sparse", GrB.Sparse, true},
+			{"iso full", GrB.Full, true},
+		} {
+			A := readTestMatrix[float64](t, aname)
+			n, m, err := A.Size()
+			try(err)
+			nvals, err := A.Nvals()
+			try(err)
+			if test.sparsity == GrB.Full && nvals != n*m {
+				try(A.Free())
+				continue
+			}
+			if test.iso {
+				try(GrB.MatrixApply(A, nil, nil, GrB.One[float64](), A, nil))
+			}
+			try(A.SetSparsityControl(test.sparsity))
+			try(A.Wait(GrB.Materialize))
+			status, err := A.GetSparsityStatus()
+			try(err)
+			if status != test.sparsity && nvals > 0 {
+				t.Errorf("%v %v: sparsity is %v", aname, test.name, status)
+			}
+			iso, err := A.Iso()
+			try(err)
+			if test.iso && !iso && nvals > 0 {
+				t.Errorf("%v %v: matrix is not iso", aname, test.name)
+			}
+
+			var buf bytes.Buffer
+			try(Binary.BinWrite(&buf, A))
+			B, err := Binary.BinRead[float64](&buf)
+			try(err)
+			ok, err := LAGraph.MatrixIsEqual(A, B)
+			try(err)
+			if !ok {
+				t.Errorf("%v %v: matrix read differs from the matrix written", aname, test.name)
+			}
+			try(A.Free())
+			try(B.Free())
+		}
+	}
+
+	// nrows*ncols overflows 64 bits for a large hypersparse matrix
+	const huge = 1 << 33
+	A, err := GrB.MatrixNew[float64](huge, huge)
+	try(err)
+	try(A.Build([]int{0, 42, huge - 1}, []int{huge - 1, 7, 0}, []float64{1, 2, 3}, nil))
+	var buf bytes.Buffer
+	try(Binary.BinWrite(&buf, A))
+	B, err := Binary.BinRead[float64](&buf)
+	try(err)
+	if err == nil {
+		ok, err := LAGraph.MatrixIsEqual(A, B)
+		try(err)
+		if !ok {
+			t.Error("huge hypersparse: matrix read differs from the matrix written")
+		}
+		try(B.Free())
+	}
+	try(A.Free())
+}
+
+func TestBinaryMalformed(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	f, err := os.Open(filepath.Join("testdata", "garbage.lagraph"))
+	try(err)
+	if _, err = Binary.BinRead[float64](f); err == nil {
+		t.Error("garbage.lagraph: expected an error")
+	}
+	try(f.Close())
+
+	A := readTestMatrix[float64](t, "west0067.mtx")
+	var buf bytes.Buffer
+	try(Binary.BinWrite(&buf, A))
+	try(A.Free())
+	data := buf.Bytes()
+
+	for _, test := range []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"truncated text header", data[:100]},
+		{"truncated binary header", data[:520]},
+		{"truncated content", data[:len(data)-8]},
+		{"invalid format", corrupt(data, 512, 7)},
+		{"invalid sparsity", corrupt(data, 516, 3)},
+		{"invalid type", corrupt(data, 512+4+4+8+5*8, 42)},
+	} {
+		if B, err := Binary.BinRead[float64](bytes.NewReader(test.data)); err == nil {
+			t.Errorf("%v: expected an error", test.name)
+			try(B.Free())
+		}
+	}
+}
+
+// corrupt returns a copy of data with the int32 at offset replaced by value
+func corrupt(data []byte, offset int, value byte) []byte {
+	result := bytes.Clone(data)
+	copy(result[offset:offset+4], []byte{value, 0, 0, 0})
+	return result
+}
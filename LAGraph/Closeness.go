@@ -0,0 +1,207 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+)
+
+// closenessBatchSize is the number of sources whose path lengths are computed at once.
+const closenessBatchSize = 64
+
+// pathLengthCentrality computes the closeness or harmonic centrality of the n nodes
+// of a graph from the lengths of the shortest paths from the given sources, or from
+// all nodes if sources is nil. pathLengths returns a matrix with a row for each source
+// of a batch, holding the lengths of the paths to all other nodes it reaches.
+// Duplicate sources are rejected.
+func pathLengthCentrality(n int, sources []int, harmonic bool, pathLengths func(batch []int) (GrB.Matrix[float64], error)) (centrality GrB.Vector[float64], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	if sources == nil {
+		sources = make([]int, n)
+		for i := range sources {
+			sources[i] = i
+		}
+	}
+	// nsources[v] is the number of sources other than v
+	nsources := make([]int, n)
+	for i := range nsources {
+		nsources[i] = len(sources)
+	}
+	seen := make([]bool, n)
+	for _, src := range sources {
+		if src < 0 || src >= n {
+			err = errors.New("invalid source node")
+			return
+		}
+		if seen[src] {
+			err = errors.New("duplicate source node")
+			return
+		}
+		seen[src] = true
+		nsources[src]--
+	}
+
+	sum, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer try(sum.Free)
+	count, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer try(count.Free)
+	GrB.OK(GrB.VectorAssignConstant(sum, nil, nil, 0, GrB.All(n), nil))
+	GrB.OK(GrB.VectorAssignConstant(count, nil, nil, 0, GrB.All(n), nil))
+
+	plus := GrB.Plus[float64]()
+	plusMonoid := GrB.PlusMonoid[float64]()
+	for start := 0; start < len(sources); start += closenessBatchSize {
+		L, e := pathLengths(sources[start:min(start+closenessBatchSize, len(sources))])
+		GrB.OK(e)
+		func() {
+			defer try(L.Free)
+			if harmonic {
+				GrB.OK(GrB.MatrixApply(L, nil, nil, GrB.Minv[float64](), L, nil))
+				GrB.OK(GrB.MatrixReduceMonoid(sum, nil, &plus, plusMonoid, L, GrB.DescT0))
+			} else {
+				GrB.OK(GrB.MatrixReduceMonoid(sum, nil, &plus, plusMonoid, L, GrB.DescT0))
+				GrB.OK(GrB.MatrixApply(L, nil, nil, GrB.One[float64](), L, nil))
+				GrB.OK(GrB.MatrixReduceMonoid(count, nil, &plus, plusMonoid, L, GrB.DescT0))
+			}
+		}()
+	}
+
+	var index []int
+	var s, c []float64
+	GrB.OK(sum.ExtractTuples(&index, &s))
+	GrB.OK(count.ExtractTuples(&index, &c))
+	values := make([]float64, n)
+	for v := range values {
+		if nsources[v] == 0 {
+			continue
+		}
+		if harmonic {
+			// a sample of sources is scaled up to all n-1 other nodes
+			values[v] = s[v] * float64(n-1) / float64(nsources[v])
+		} else if s[v] > 0 {
+			// Wasserman-Faust: the fraction of the other sources that reach v, times
+			// the inverse of the mean length of their paths to v
+			values[v] = (c[v] / float64(nsources[v])) * (c[v] / s[v])
+		}
+	}
+	centrality, err = GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = centrality.Free()
+		}
+	}()
+	GrB.OK(centrality.Build(index, values, nil))
+	return
+}
+
+func (G *Graph[D]) bfsPathLengths(batch []int) (L GrB.Matrix[float64], err error) {
+	defer GrB.CheckErrors(&err)
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	level, _, err := G.MultiSourceBFS(batch, true, false)
+	GrB.OK(err)
+	defer func() {
+		GrB.OK(level.Free())
+	}()
+	L, err = GrB.MatrixNew[float64](len(batch), n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = L.Free()
+		}
+	}()
+	GrB.OK(GrB.MatrixSelect(L, nil, nil, GrB.Valuegt[float64](), GrB.MatrixView[float64, int](level), 0, nil))
+	return
+}
+
+// checkPositiveWeights returns an error if any value of A is not positive.
+func checkPositiveWeights[D SingleSourceShortestPathDomains](A GrB.Matrix[D]) (err error) {
+	defer GrB.CheckErrors(&err)
+	nvals, err := A.Nvals()
+	GrB.OK(err)
+	if nvals > 0 {
+		emin, e := GrB.MatrixReduce(GrB.MinMonoid[D](), A, nil)
+		GrB.OK(e)
+		if emin <= 0 {
+			err = errors.New("edge weights must be positive")
+		}
+	}
+	return
+}
+
+func ssspPathLengths[D SingleSourceShortestPathDomains](G *Graph[D], delta D) func(batch []int) (GrB.Matrix[float64], error) {
+	return func(batch []int) (L GrB.Matrix[float64], err error) {
+		defer GrB.CheckErrors(&err)
+		n, err := G.A.Nrows()
+		GrB.OK(err)
+		L, err = GrB.MatrixNew[float64](len(batch), n)
+		GrB.OK(err)
+		defer func() {
+			if err != nil {
+				_ = L.Free()
+			}
+		}()
+		for k, src := range batch {
+			pathLength, e := SingleSourceShortestPath(G, src, delta)
+			GrB.OK(e)
+			func() {
+				defer func() {
+					GrB.OK(pathLength.Free())
+				}()
+				GrB.OK(GrB.VectorSelect(pathLength, nil, nil, GrB.Valuelt[D](), pathLength, GrB.Maximum[D](), nil))
+				GrB.OK(pathLength.RemoveElement(src))
+				GrB.OK(GrB.MatrixRowAssign(L, nil, nil, GrB.VectorView[float64, D](pathLength), k, GrB.All(n), nil))
+			}()
+		}
+		return
+	}
+}
+
+// ClosenessCentrality computes the Wasserman-Faust closeness centrality of the nodes
+// of G, ignoring edge weights, from the given sources, or from all nodes if sources is nil.
+func (G *Graph[D]) ClosenessCentrality(sources []int) (centrality GrB.Vector[float64], err error) {
+	defer GrB.CheckErrors(&err)
+	GrB.OK(G.Check())
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	return pathLengthCentrality(n, sources, false, G.bfsPathLengths)
+}
+
+// HarmonicCentrality computes the harmonic centrality of the nodes of G, ignoring
+// edge weights, from the given sources, or from all nodes if sources is nil.
+func (G *Graph[D]) HarmonicCentrality(sources []int) (centrality GrB.Vector[float64], err error) {
+	defer GrB.CheckErrors(&err)
+	GrB.OK(G.Check())
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	return pathLengthCentrality(n, sources, true, G.bfsPathLengths)
+}
+
+// WeightedClosenessCentrality is ClosenessCentrality with the values of G.A as edge
+// weights, which must be positive, computing the shortest paths with
+// SingleSourceShortestPath.
+func WeightedClosenessCentrality[D SingleSourceShortestPathDomains](G *Graph[D], sources []int, delta D) (centrality GrB.Vector[float64], err error) {
+	defer GrB.CheckErrors(&err)
+	GrB.OK(G.Check())
+	GrB.OK(checkPositiveWeights(G.A))
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	return pathLengthCentrality(n, sources, false, ssspPathLengths(G, delta))
+}
+
+// WeightedHarmonicCentrality is HarmonicCentrality with the values of G.A as edge
+// weights, which must be positive, computing the shortest paths with
+// SingleSourceShortestPath.
+func WeightedHarmonicCentrality[D SingleSourceShortestPathDomains](G *Graph[D], sources []int, delta D) (centrality GrB.Vector[float64], err error) {
+	defer GrB.CheckErrors(&err)
+	GrB.OK(G.Check())
+	GrB.OK(checkPositiveWeights(G.A))
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	return pathLengthCentrality(n, sources, true, ssspPathLengths(G, delta))
+}
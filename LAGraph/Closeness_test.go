@@ -0,0 +1,169 @@
+package LAGraph_test
+
+import (
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// referenceDistances computes the lengths of the shortest paths from src with Dijkstra's algorithm
+func referenceDistances(n int, I, J []int, X []float64, src int) []float64 {
+	distance := make([]float64, n)
+	for i := range distance {
+		distance[i] = math.Inf(1)
+	}
+	distance[src] = 0
+	done := make([]bool, n)
+	for {
+		u := -1
+		for i := range n {
+			if !done[i] && !math.IsInf(distance[i], 1) && (u < 0 || distance[i] < distance[u]) {
+				u = i
+			}
+		}
+		if u < 0 {
+			return distance
+		}
+		done[u] = true
+		for e := range I {
+			if I[e] == u && distance[u]+X[e] < distance[J[e]] {
+				distance[J[e]] = distance[u] + X[e]
+			}
+		}
+	}
+}
+
+func referenceCloseness(n int, I, J []int, X []float64, sources []int) (closeness, harmonic []float64) {
+	if sources == nil {
+		sources = make([]int, n)
+		for i := range sources {
+			sources[i] = i
+		}
+	}
+	distances := make([][]float64, len(sources))
+	for k, src := range sources {
+		distances[k] = referenceDistances(n, I, J, X, src)
+	}
+	closeness = make([]float64, n)
+	harmonic = make([]float64, n)
+	for v := range n {
+		nsources, reached := 0, 0
+		sum, inverseSum := 0.0, 0.0
+		for k, src := range sources {
+			if src == v {
+				continue
+			}
+			nsources++
+			if d := distances[k][v]; !math.IsInf(d, 1) {
+				reached++
+				sum += d
+				inverseSum += 1 / d
+			}
+		}
+		if nsources > 0 {
+			harmonic[v] = inverseSum * float64(n-1) / float64(nsources)
+			if sum > 0 {
+				closeness[v] = float64(reached) / float64(nsources) * float64(reached) / sum
+			}
+		}
+	}
+	return
+}
+
+func checkCentrality(t *testing.T, name string, centrality GrB.Vector[float64], expected []float64) {
+	var I []int
+	var X []float64
+	if err := centrality.ExtractTuples(&I, &X); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(I) != len(expected) {
+		t.Errorf("%v has %v entries, expected %v", name, len(I), len(expected))
+		return
+	}
+	for p, i := range I {
+		if math.Abs(X[p]-expected[i]) > 1e-9 {
+			t.Errorf("%v(%v) is %v, expected %v", name, i, X[p], expected[i])
+			return
+		}
+	}
+}
+
+func TestClosenessCentrality(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, test := range []struct {
+		aname string
+		kind  LAGraph.Kind
+		delta float64
+	}{
+		{"karate.mtx", LAGraph.AdjacencyUndirected, 1},
+		{"west0067.mtx", LAGraph.AdjacencyDirected, 1},
+		{"ldbc-directed-example.mtx", LAGraph.AdjacencyDirected, 0.5},
+		{"ldbc-undirected-example.mtx", LAGraph.AdjacencyUndirected, 0.5},
+		{"cover.mtx", LAGraph.AdjacencyDirected, 2},
+	} {
+		f, err := os.Open(filepath.Join("testdata", test.aname))
+		try(err)
+		A, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		n, err := A.Nrows()
+		try(err)
+		// west0067 has negative entries, so only the lengths of its edges are used
+		try(GrB.MatrixApply(A, nil, nil, GrB.Abs[float64](), A, nil))
+		var I, J []int
+		var X []float64
+		try(A.ExtractTuples(&I, &J, &X))
+		ones := make([]float64, len(I))
+		for e := range ones {
+			ones[e] = 1
+		}
+		G := LAGraph.New(A, test.kind)
+
+		for _, sources := range [][]int{nil, {0, 2, n - 1}, {3, 1}} {
+			closeness, harmonic := referenceCloseness(n, I, J, ones, sources)
+			centrality, err := G.ClosenessCentrality(sources)
+			try(err)
+			checkCentrality(t, test.aname+" closeness", centrality, closeness)
+			try(centrality.Free())
+			centrality, err = G.HarmonicCentrality(sources)
+			try(err)
+			checkCentrality(t, test.aname+" harmonic", centrality, harmonic)
+			try(centrality.Free())
+
+			closeness, harmonic = referenceCloseness(n, I, J, X, sources)
+			centrality, err = LAGraph.WeightedClosenessCentrality(G, sources, test.delta)
+			try(err)
+			checkCentrality(t, test.aname+" weighted closeness", centrality, closeness)
+			try(centrality.Free())
+			centrality, err = LAGraph.WeightedHarmonicCentrality(G, sources, test.delta)
+			try(err)
+			checkCentrality(t, test.aname+" weighted harmonic", centrality, harmonic)
+			try(centrality.Free())
+		}
+
+		if _, err = G.ClosenessCentrality([]int{n}); err == nil {
+			t.Errorf("%v: expected an error for an invalid source", test.aname)
+		}
+		if _, err = G.HarmonicCentrality([]int{1, 1, 3}); err == nil {
+			t.Errorf("%v: expected an error for a duplicate source", test.aname)
+		}
+		try(G.A.SetElement(0, I[0], J[0]))
+		if _, err = LAGraph.WeightedClosenessCentrality(G, nil, test.delta); err == nil {
+			t.Errorf("%v: expected an error for a zero edge weight", test.aname)
+		}
+		try(G.A.SetElement(-1, I[0], J[0]))
+		if _, err = LAGraph.WeightedHarmonicCentrality(G, nil, test.delta); err == nil {
+			t.Errorf("%v: expected an error for a negative edge weight", test.aname)
+		}
+		try(G.Delete())
+	}
+}
@@ -0,0 +1,183 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"math/rand"
+)
+
+type ColoringMethod int
+
+const (
+	ColoringAutoMethod ColoringMethod = iota
+	ColoringJonesPlassmann
+	ColoringLargestDegreeFirst
+	ColoringSmallestLast
+)
+
+func (m ColoringMethod) String() string {
+	switch m {
+	case ColoringAutoMethod:
+		return "auto"
+	case ColoringJonesPlassmann:
+		return "Jones-Plassmann"
+	case ColoringLargestDegreeFirst:
+		return "largest degree first"
+	case ColoringSmallestLast:
+		return "smallest last"
+	default:
+		panic("invalid coloring method")
+	}
+}
+
+// Coloring colors the nodes of G with the colors 1 to ncolors so that adjacent nodes
+// differ. G.A must be symmetric without self edges, and all methods except
+// Jones-Plassmann require G.OutDegree.
+func (G *Graph[D]) Coloring(inMethod ColoringMethod, seed int64) (color GrB.Vector[int], ncolors int, method ColoringMethod, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	method = inMethod
+
+	GrB.OK(G.Check())
+	if !(G.Kind == AdjacencyUndirected || (G.Kind == AdjacencyDirected && G.IsSymmetricStructure == True)) {
+		err = errors.New("G.A must be known to be symmetric")
+		return
+	}
+	if G.NSelfEdges != 0 {
+		err = errors.New("no self edges allowed")
+		return
+	}
+	if method == ColoringAutoMethod {
+		method = ColoringJonesPlassmann
+	}
+
+	A := G.A
+	n, err := A.Nrows()
+	GrB.OK(err)
+
+	// every node gets a unique priority: random for Jones-Plassmann, the degree with
+	// random tie-breaking for largest degree first, and the reverse degeneracy
+	// ordering for smallest last, which uses at most degeneracy+1 colors
+	var order []int
+	switch method {
+	case ColoringJonesPlassmann:
+		order = rand.New(rand.NewSource(seed)).Perm(n)
+	case ColoringLargestDegreeFirst:
+		if !G.OutDegree.Valid() {
+			err = errors.New("G.OutDegree must be defined")
+			return
+		}
+		order = rand.New(rand.NewSource(seed)).Perm(n)
+	case ColoringSmallestLast:
+		order, err = G.DegeneracyOrdering()
+		GrB.OK(err)
+	default:
+		err = errors.New("invalid coloring method")
+		return
+	}
+
+	// the priority of node order[r] is r+1, so the last node in order comes first
+	index := make([]int, n)
+	priority := make([]int, n)
+	for r, i := range order {
+		priority[i] = r + 1
+	}
+	if method == ColoringLargestDegreeFirst {
+		// breaking ties by index instead would color a path of nodes with equal
+		// degrees one node per round
+		var degreeIndex, degree []int
+		GrB.OK(G.OutDegree.ExtractTuples(&degreeIndex, &degree))
+		for k, i := range degreeIndex {
+			priority[i] += degree[k] * n
+		}
+	}
+	for i := range index {
+		index[i] = i
+	}
+
+	color, err = GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = color.Free()
+		}
+	}()
+	uncolored, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(uncolored.Free)
+	GrB.OK(uncolored.Build(index, priority, nil))
+	neighborMax, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(neighborMax.Free)
+	members, err := GrB.VectorNew[bool](n)
+	GrB.OK(err)
+	defer try(members.Free)
+	newColor, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(newColor.Free)
+	C, err := GrB.MatrixNew[int](n, n)
+	GrB.OK(err)
+	defer try(C.Free)
+
+	// in each round, the uncolored nodes with a higher priority than all their
+	// uncolored neighbors form an independent set, and each of them gets the
+	// smallest color not used by its colored neighbors, as in the greedy coloring
+	// in decreasing priority order; C is the diagonal matrix of the colors so far
+	maxSecond := GrB.MaxSecondSemiring[int]()
+	minSecond := GrB.MinSecondSemiring[int]()
+	Ai := GrB.MatrixView[int, D](A)
+	for {
+		nuncolored, e := uncolored.Nvals()
+		GrB.OK(e)
+		if nuncolored == 0 {
+			break
+		}
+		GrB.OK(GrB.MxV(neighborMax, uncolored.AsMask(), nil, maxSecond, Ai, uncolored, GrB.DescRS))
+		GrB.OK(GrB.VectorEWiseAddBinaryOp(members, nil, nil, GrB.Gt[int](), uncolored, neighborMax, nil))
+		GrB.OK(GrB.VectorSelect(members, nil, nil, GrB.Valueeq[bool](), members, true, nil))
+		var memberIndex []int
+		GrB.OK(members.ExtractTuples(&memberIndex, nil))
+
+		// N(r, j) is the color of the colored neighbor j of node memberIndex[r]
+		var NI, NX []int
+		func() {
+			N, e := GrB.MatrixNew[int](len(memberIndex), n)
+			GrB.OK(e)
+			defer try(N.Free)
+			GrB.OK(GrB.MatrixExtract(N, nil, nil, Ai, memberIndex, GrB.All(n), nil))
+			GrB.OK(GrB.MxM(N, nil, nil, minSecond, N, C, nil))
+			GrB.OK(N.ExtractTuples(&NI, nil, &NX))
+		}()
+
+		// each member gets the smallest color that none of its neighbors has
+		neighborColors := make([][]int, len(memberIndex))
+		for k, r := range NI {
+			neighborColors[r] = append(neighborColors[r], NX[k])
+		}
+		colors := make([]int, len(memberIndex))
+		for r, cs := range neighborColors {
+			used := make([]bool, len(cs)+2)
+			for _, c := range cs {
+				if c <= len(cs) {
+					used[c] = true
+				}
+			}
+			c := 1
+			for used[c] {
+				c++
+			}
+			colors[r] = c
+			ncolors = max(ncolors, c)
+		}
+		GrB.OK(newColor.Clear())
+		GrB.OK(newColor.Build(memberIndex, colors, nil))
+		GrB.OK(GrB.VectorAssign(color, newColor.AsMask(), nil, newColor, GrB.All(n), GrB.DescS))
+		for r, i := range memberIndex {
+			GrB.OK(C.SetElement(colors[r], i, i))
+		}
+		GrB.OK(GrB.VectorApply(uncolored, members.AsMask(), nil, GrB.Identity[int](), uncolored, GrB.DescRSC))
+	}
+	return
+}
@@ -0,0 +1,146 @@
+package LAGraph_test
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func checkColoring(color GrB.Vector[int], ncolors, n int, I, J []int) (err error) {
+	defer GrB.CheckErrors(&err)
+	c, err := checkVector(color, n, -1)
+	GrB.OK(err)
+	used := make([]bool, ncolors+1)
+	for i := range n {
+		if c[i] < 1 || c[i] > ncolors {
+			return errors.New("color out of range")
+		}
+		used[c[i]] = true
+	}
+	for k := 1; k <= ncolors; k++ {
+		if !used[k] {
+			return errors.New("unused color")
+		}
+	}
+	for e := range I {
+		if c[I[e]] == c[J[e]] {
+			return errors.New("adjacent nodes have the same color")
+		}
+	}
+	return
+}
+
+func TestColoring(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, aname := range []string{"karate.mtx", "A.mtx", "jagmesh7.mtx", "LFAT5_two.mtx", "bcsstk13.mtx", "tree-example.mtx"} {
+		f, err := os.Open(filepath.Join("testdata", aname))
+		try(err)
+		M, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		n, err := M.Nrows()
+		try(err)
+		var I, J []int
+		try(M.ExtractTuples(&I, &J, nil))
+		try(M.Free())
+		A, err := GrB.MatrixNew[int](n, n)
+		try(err)
+		try(A.Build(I, J, make([]int, len(I)), nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyUndirected)
+		try(G.DeleteSelfEdges())
+		try(G.A.ExtractTuples(&I, &J, nil))
+		try(G.CachedNSelfEdges())
+
+		if _, _, _, err = G.Coloring(LAGraph.ColoringLargestDegreeFirst, 0); err == nil {
+			t.Error("expected an error without G.OutDegree")
+		}
+		try(G.CachedOutDegree())
+		degree := make([]int, n)
+		for _, i := range I {
+			degree[i]++
+		}
+		maxDegree := slices.Max(degree)
+
+		for _, method := range []LAGraph.ColoringMethod{
+			LAGraph.ColoringAutoMethod,
+			LAGraph.ColoringJonesPlassmann,
+			LAGraph.ColoringLargestDegreeFirst,
+			LAGraph.ColoringSmallestLast,
+		} {
+			color, ncolors, usedMethod, err := G.Coloring(method, 7)
+			try(err)
+			if method == LAGraph.ColoringAutoMethod && usedMethod != LAGraph.ColoringJonesPlassmann {
+				t.Errorf("unexpected automatic method %v", usedMethod)
+			}
+			if err = checkColoring(color, ncolors, n, I, J); err != nil {
+				t.Errorf("%v, method %v: %v", aname, usedMethod, err)
+			}
+			if ncolors > maxDegree+1 {
+				t.Errorf("%v, method %v: %v colors, expected at most %v", aname, usedMethod, ncolors, maxDegree+1)
+			}
+			try(color.Free())
+		}
+		try(G.Delete())
+	}
+}
+
+func TestColoringForest(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	// a path of 20 nodes, a binary tree of 31 nodes, and 3 isolated nodes
+	const n = 20 + 31 + 3
+	var I, J []int
+	addEdge := func(i, j int) {
+		I = append(I, i, j)
+		J = append(J, j, i)
+	}
+	for i := 1; i < 20; i++ {
+		addEdge(i-1, i)
+	}
+	for i := 1; i < 31; i++ {
+		addEdge(20+(i-1)/2, 20+i)
+	}
+	A, err := GrB.MatrixNew[int](n, n)
+	try(err)
+	try(A.Build(I, J, make([]int, len(I)), nil))
+	G := LAGraph.New(A, LAGraph.AdjacencyUndirected)
+	try(G.CachedNSelfEdges())
+	try(G.CachedOutDegree())
+
+	for _, method := range []LAGraph.ColoringMethod{
+		LAGraph.ColoringJonesPlassmann,
+		LAGraph.ColoringLargestDegreeFirst,
+		LAGraph.ColoringSmallestLast,
+	} {
+		for seed := range int64(10) {
+			color, ncolors, _, err := G.Coloring(method, seed)
+			try(err)
+			if err = checkColoring(color, ncolors, n, I, J); err != nil {
+				t.Errorf("forest, method %v: %v", method, err)
+			}
+			// greedy colorings of a forest use at most maxDegree+1 colors, and the smallest
+			// last ordering uses at most degeneracy+1 colors
+			bound := 4
+			if method == LAGraph.ColoringSmallestLast {
+				bound = 2
+			}
+			if ncolors > bound {
+				t.Errorf("forest, method %v: %v colors, expected at most %v", method, ncolors, bound)
+			}
+			try(color.Free())
+		}
+	}
+	try(G.Delete())
+}
@@ -31,6 +31,40 @@ func (G *Graph[D]) ConnectedComponents() (component GrB.Vector[int], err error)
 	return
 }
 
+// WeaklyConnectedComponents computes the connected components of G.A, ignoring
+// the direction of its edges.
+func (G *Graph[D]) WeaklyConnectedComponents() (component GrB.Vector[int], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+
+	GrB.OK(G.Check())
+	if G.Kind == AdjacencyUndirected || (G.Kind == AdjacencyDirected && G.IsSymmetricStructure == True) {
+		return G.ConnectedComponents()
+	}
+
+	A := GrB.MatrixView[bool, D](G.A)
+	n, err := A.Nrows()
+	GrB.OK(err)
+	S, err := GrB.MatrixNew[bool](n, n)
+	GrB.OK(err)
+	defer try(S.Free)
+	if G.AT.Valid() {
+		GrB.OK(GrB.MatrixEWiseAddBinaryOp(S, nil, nil, GrB.Oneb[bool](), A, GrB.MatrixView[bool, D](G.AT), nil))
+	} else {
+		GrB.OK(GrB.MatrixEWiseAddBinaryOp(S, nil, nil, GrB.Oneb[bool](), A, A, GrB.DescT1))
+	}
+	nvals, err := S.Nvals()
+	GrB.OK(err)
+	if n > math.MaxInt32 {
+		component = connectedComponents[bool, int64, uint64](S, n, nvals)
+	} else {
+		component = connectedComponents[bool, int32, uint32](S, n, nvals)
+	}
+	return
+}
+
 func fastsv[Uint uint32 | uint64](
 	A GrB.Matrix[bool],
 	parent, mngp GrB.Vector[Uint],
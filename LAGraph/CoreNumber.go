@@ -0,0 +1,152 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+)
+
+// coreNumber peels the nodes of minimum remaining degree, level by level. If
+// computeOrder is true, it also returns the nodes in the order they are peeled.
+func (G *Graph[D]) coreNumber(computeOrder bool) (core GrB.Vector[int], order []int, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+
+	GrB.OK(G.Check())
+	if !(G.Kind == AdjacencyUndirected || (G.Kind == AdjacencyDirected && G.IsSymmetricStructure == True)) {
+		err = errors.New("G.A must be known to be symmetric")
+		return
+	}
+	if G.NSelfEdges != 0 {
+		err = errors.New("no self edges allowed")
+		return
+	}
+	if !G.OutDegree.Valid() {
+		err = errors.New("G.OutDegree must be defined")
+		return
+	}
+
+	A := GrB.MatrixView[int, D](G.A)
+	n, err := A.Nrows()
+	GrB.OK(err)
+
+	core, err = GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = core.Free()
+		}
+	}()
+	GrB.OK(GrB.VectorAssignConstant(core, nil, nil, 0, GrB.All(n), nil))
+
+	deg, err := G.OutDegree.Dup()
+	GrB.OK(err)
+	defer try(deg.Free)
+	q, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(q.Free)
+	delta, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(delta.Free)
+
+	if computeOrder {
+		GrB.OK(GrB.VectorAssignConstant(q, deg.AsMask(), nil, 0, GrB.All(n), GrB.DescRSC))
+		GrB.OK(q.ExtractTuples(&order, nil))
+	}
+
+	minus := GrB.Minus[int]()
+	le := GrB.Valuele[int]()
+	plusOne := PlusOne[int]()
+	level := 0
+	var peeled []int
+	for {
+		ndeg, e := deg.Nvals()
+		GrB.OK(e)
+		if ndeg == 0 {
+			break
+		}
+		minDeg, e := GrB.VectorReduce(GrB.MinMonoid[int](), deg, nil)
+		GrB.OK(e)
+		level = max(level, minDeg)
+		for {
+			GrB.OK(GrB.VectorSelect(q, nil, nil, le, deg, level, nil))
+			nq, e := q.Nvals()
+			GrB.OK(e)
+			if nq == 0 {
+				break
+			}
+			if computeOrder {
+				GrB.OK(q.ExtractTuples(&peeled, nil))
+				order = append(order, peeled...)
+			}
+			GrB.OK(GrB.VectorAssignConstant(core, q.AsMask(), nil, level, GrB.All(n), GrB.DescS))
+			GrB.OK(GrB.VectorApply(deg, q.AsMask(), nil, GrB.Identity[int](), deg, GrB.DescRSC))
+			GrB.OK(GrB.MxV(delta, deg.AsMask(), nil, plusOne, A, q, GrB.DescRS))
+			GrB.OK(GrB.VectorEWiseAddBinaryOp(deg, nil, nil, minus, deg, delta, nil))
+		}
+	}
+	return
+}
+
+// CoreNumber computes the core number of every node, the largest k such that
+// the node belongs to the k-core of G. G.OutDegree is required.
+func (G *Graph[D]) CoreNumber() (core GrB.Vector[int], err error) {
+	core, _, err = G.coreNumber(false)
+	return
+}
+
+// KCore extracts the k-core of G, the subgraph induced by the nodes with core
+// number k or more. C has the same dimensions as G.A.
+func (G *Graph[D]) KCore(k int) (C GrB.Matrix[D], core GrB.Vector[int], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	core, _, err = G.coreNumber(false)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = core.Free()
+		}
+	}()
+	n, err := core.Size()
+	GrB.OK(err)
+
+	keep, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(keep.Free)
+	GrB.OK(GrB.VectorSelect(keep, nil, nil, GrB.Valuege[int](), core, k, nil))
+	var nodes []int
+	GrB.OK(keep.ExtractTuples(&nodes, nil))
+
+	C, err = GrB.MatrixNew[D](n, n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = C.Free()
+		}
+	}()
+	S, err := GrB.MatrixNew[D](len(nodes), len(nodes))
+	GrB.OK(err)
+	defer try(S.Free)
+	GrB.OK(GrB.MatrixExtract(S, nil, nil, G.A, nodes, nodes, nil))
+	GrB.OK(GrB.MatrixAssign(C, nil, nil, S, nodes, nodes, nil))
+	return
+}
+
+// DegeneracyOrdering returns the nodes of G in the order in which the core number
+// computation peels them, starting with the isolated nodes. Nodes peeled at the
+// same step are in ascending order. Like SortByDegree, the
+// result is a permutation vector. G.OutDegree is required.
+func (G *Graph[D]) DegeneracyOrdering() (permutationVector []int, err error) {
+	core, order, err := G.coreNumber(true)
+	if err != nil {
+		return
+	}
+	if err = core.Free(); err != nil {
+		return
+	}
+	permutationVector = order
+	return
+}
@@ -0,0 +1,148 @@
+package LAGraph_test
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// referenceCoreNumber repeatedly removes a node of minimum remaining degree
+func referenceCoreNumber(n int, I, J []int) []int {
+	degree := make([]int, n)
+	adj := make([][]int, n)
+	for e := range I {
+		degree[I[e]]++
+		adj[I[e]] = append(adj[I[e]], J[e])
+	}
+	removed := make([]bool, n)
+	core := make([]int, n)
+	level := 0
+	for range n {
+		v := -1
+		for i := range n {
+			if !removed[i] && (v < 0 || degree[i] < degree[v]) {
+				v = i
+			}
+		}
+		level = max(level, degree[v])
+		core[v] = level
+		removed[v] = true
+		for _, w := range adj[v] {
+			degree[w]--
+		}
+	}
+	return core
+}
+
+func checkDegeneracyOrdering(order, core []int, n int, I, J []int) error {
+	if len(order) != n {
+		return errors.New("ordering has wrong length")
+	}
+	position := make([]int, n)
+	for i := range position {
+		position[i] = -1
+	}
+	for p, v := range order {
+		if v < 0 || v >= n || position[v] >= 0 {
+			return errors.New("ordering is not a permutation")
+		}
+		position[v] = p
+	}
+	later := make([]int, n)
+	for e := range I {
+		if position[J[e]] > position[I[e]] {
+			later[I[e]]++
+		}
+	}
+	for p, v := range order {
+		if later[v] > core[v] {
+			return errors.New("node has too many later neighbors")
+		}
+		if p > 0 && core[order[p-1]] > core[v] {
+			return errors.New("core numbers decrease along the ordering")
+		}
+	}
+	return nil
+}
+
+func TestCoreNumber(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, aname := range []string{"karate.mtx", "A.mtx", "jagmesh7.mtx", "ldbc-undirected-example.mtx", "LFAT5_two.mtx", "bcsstk13.mtx", "tree-example.mtx"} {
+		f, err := os.Open(filepath.Join("testdata", aname))
+		try(err)
+		M, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		n, err := M.Nrows()
+		try(err)
+		var I, J []int
+		try(M.ExtractTuples(&I, &J, nil))
+		try(M.Free())
+		A, err := GrB.MatrixNew[int](n, n)
+		try(err)
+		try(A.Build(I, J, make([]int, len(I)), nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyUndirected)
+		try(G.DeleteSelfEdges())
+		try(G.A.ExtractTuples(&I, &J, nil))
+		try(G.CachedNSelfEdges())
+
+		if _, err = G.CoreNumber(); err == nil {
+			t.Error("expected an error without G.OutDegree")
+		}
+		try(G.CachedOutDegree())
+
+		expected := referenceCoreNumber(n, I, J)
+		core, err := G.CoreNumber()
+		try(err)
+		result, err := checkVector(core, n, -1)
+		try(err)
+		for i := range n {
+			if result[i] != expected[i] {
+				t.Errorf("%v: node %v: expected core number %v, got %v", aname, i, expected[i], result[i])
+				break
+			}
+		}
+		try(core.Free())
+
+		order, err := G.DegeneracyOrdering()
+		try(err)
+		try(checkDegeneracyOrdering(order, expected, n, I, J))
+
+		kmax := 0
+		for _, c := range expected {
+			kmax = max(kmax, c)
+		}
+		for _, k := range []int{1, kmax/2 + 1, kmax} {
+			C, core, err := G.KCore(k)
+			try(err)
+			var CI, CJ []int
+			try(C.ExtractTuples(&CI, &CJ, nil))
+			nedges := 0
+			for e := range I {
+				if expected[I[e]] >= k && expected[J[e]] >= k {
+					nedges++
+				}
+			}
+			if len(CI) != nedges {
+				t.Errorf("%v: expected %v entries in the %v-core, got %v", aname, nedges, k, len(CI))
+			}
+			for e := range CI {
+				if expected[CI[e]] < k || expected[CJ[e]] < k {
+					t.Errorf("%v: edge (%v, %v) is not in the %v-core", aname, CI[e], CJ[e], k)
+					break
+				}
+			}
+			try(C.Free())
+			try(core.Free())
+		}
+		try(G.Delete())
+	}
+}
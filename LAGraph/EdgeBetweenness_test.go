@@ -0,0 +1,119 @@
+package LAGraph_test
+
+import (
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// referenceEdgeBetweenness accumulates the dependencies of the sources on the edges with Brandes' algorithm
+func referenceEdgeBetweenness(n int, I, J []int, sources []int) map[edge]float64 {
+	adj := make([][]int, n)
+	for e := range I {
+		adj[I[e]] = append(adj[I[e]], J[e])
+	}
+	result := make(map[edge]float64)
+	for e := range I {
+		result[edge{I[e], J[e]}] = 0
+	}
+	for _, s := range sources {
+		depth := make([]int, n)
+		for i := range depth {
+			depth[i] = -1
+		}
+		sigma := make([]float64, n)
+		depth[s] = 0
+		sigma[s] = 1
+		order := []int{s}
+		for q := 0; q < len(order); q++ {
+			u := order[q]
+			for _, v := range adj[u] {
+				if depth[v] < 0 {
+					depth[v] = depth[u] + 1
+					order = append(order, v)
+				}
+				if depth[v] == depth[u]+1 {
+					sigma[v] += sigma[u]
+				}
+			}
+		}
+		delta := make([]float64, n)
+		for q := len(order) - 1; q >= 0; q-- {
+			u := order[q]
+			for _, v := range adj[u] {
+				if depth[v] == depth[u]+1 {
+					c := sigma[u] / sigma[v] * (1 + delta[v])
+					result[edge{u, v}] += c
+					delta[u] += c
+				}
+			}
+		}
+	}
+	return result
+}
+
+func TestEdgeBetweenness(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, test := range []struct {
+		aname string
+		kind  LAGraph.Kind
+	}{
+		{"karate.mtx", LAGraph.AdjacencyUndirected},
+		{"west0067.mtx", LAGraph.AdjacencyDirected},
+		{"ldbc-directed-example.mtx", LAGraph.AdjacencyDirected},
+	} {
+		f, err := os.Open(filepath.Join("testdata", test.aname))
+		try(err)
+		A, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		n, err := A.Nrows()
+		try(err)
+		var I, J []int
+		try(A.ExtractTuples(&I, &J, nil))
+		G := LAGraph.New(A, test.kind)
+		if test.kind == LAGraph.AdjacencyDirected {
+			_, err = G.CachedAT()
+			try(err)
+		}
+
+		all := make([]int, n)
+		for i := range all {
+			all[i] = i
+		}
+		for _, sources := range [][]int{all, {0, 3, n - 1}} {
+			edges, err := G.EdgeBetweenness(sources)
+			try(err)
+			expected := referenceEdgeBetweenness(n, I, J, sources)
+			if test.kind == LAGraph.AdjacencyUndirected {
+				symmetric := make(map[edge]float64)
+				for e, x := range expected {
+					symmetric[e] = x + expected[edge{e.j, e.i}]
+				}
+				expected = symmetric
+			}
+			var EI, EJ []int
+			var EX []float64
+			try(edges.ExtractTuples(&EI, &EJ, &EX))
+			if len(EI) != len(I) {
+				t.Errorf("%v: %v edges, expected %v", test.aname, len(EI), len(I))
+			}
+			for p := range EI {
+				x, ok := expected[edge{EI[p], EJ[p]}]
+				if !ok || math.Abs(EX[p]-x) > 1e-9 {
+					t.Errorf("%v: edge (%v, %v) is %v, expected %v", test.aname, EI[p], EJ[p], EX[p], x)
+					break
+				}
+			}
+			try(edges.Free())
+		}
+		try(G.Delete())
+	}
+}
@@ -0,0 +1,150 @@
+package LAGraph
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"io"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+type EdgeListOptions[D GrB.Number] struct {
+	// ZeroBased indicates that node ids start at 0 instead of 1.
+	ZeroBased bool
+	// Kind is the kind of the resulting graph. For AdjacencyUndirected,
+	// each edge is stored in both directions.
+	Kind Kind
+	// Weighted indicates that the third column holds the edge weight.
+	// Otherwise, additional columns are ignored and all edges have weight 1.
+	Weighted bool
+	// Dup combines the weights of duplicate edges. If nil, the first
+	// occurrence of an edge is kept.
+	Dup *GrB.BinaryOp[D, D, D]
+	// CommentPrefixes lists the prefixes of comment lines. If nil, lines
+	// starting with # or % are comments.
+	CommentPrefixes []string
+	// N is the number of nodes. If 0, it is one more than the largest node id.
+	N int
+}
+
+func makeParseWeight[D GrB.Number]() func(string) (D, error) {
+	var d D
+	bits := int(unsafe.Sizeof(d)) * 8
+	switch any(d).(type) {
+	case int, int8, int16, int32, int64:
+		return func(s string) (D, error) {
+			v, err := strconv.ParseInt(s, 10, bits)
+			return D(v), err
+		}
+	case uint, uint8, uint16, uint32, uint64:
+		return func(s string) (D, error) {
+			v, err := strconv.ParseUint(s, 10, bits)
+			return D(v), err
+		}
+	case float32, float64:
+		return func(s string) (D, error) {
+			v, err := strconv.ParseFloat(s, bits)
+			return D(v), err
+		}
+	}
+	panic("unreachable code")
+}
+
+func ReadEdgeList[D GrB.Number](r io.Reader, options EdgeListOptions[D]) (G *Graph[D], err error) {
+	if options.Kind != AdjacencyUndirected && options.Kind != AdjacencyDirected {
+		return nil, errors.New("invalid graph kind")
+	}
+	commentPrefixes := options.CommentPrefixes
+	if commentPrefixes == nil {
+		commentPrefixes = []string{"#", "%"}
+	}
+	isComment := func(line string) bool {
+		for _, prefix := range commentPrefixes {
+			if prefix != "" && strings.HasPrefix(line, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+	base := 1
+	if options.ZeroBased {
+		base = 0
+	}
+	nfields := 2
+	if options.Weighted {
+		nfields = 3
+	}
+	parseWeight := makeParseWeight[D]()
+
+	var rows, cols []int
+	var vals []D
+	n := 0
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1<<20)
+	for lineNo := 1; s.Scan(); lineNo++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || isComment(line) {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < nfields {
+			return nil, fmt.Errorf("edge list line %v has %v elements, expected at least %v", lineNo, len(fields), nfields)
+		}
+		src, e := strconv.Atoi(fields[0])
+		if e != nil {
+			return nil, fmt.Errorf("edge list line %v source parse error %w", lineNo, e)
+		}
+		dst, e := strconv.Atoi(fields[1])
+		if e != nil {
+			return nil, fmt.Errorf("edge list line %v destination parse error %w", lineNo, e)
+		}
+		src -= base
+		dst -= base
+		if src < 0 || dst < 0 {
+			return nil, fmt.Errorf("edge list line %v node id out of range", lineNo)
+		}
+		var w D = 1
+		if options.Weighted {
+			if w, e = parseWeight(fields[2]); e != nil {
+				return nil, fmt.Errorf("edge list line %v weight parse error %w", lineNo, e)
+			}
+		}
+		rows = append(rows, src)
+		cols = append(cols, dst)
+		vals = append(vals, w)
+		if options.Kind == AdjacencyUndirected && src != dst {
+			rows = append(rows, dst)
+			cols = append(cols, src)
+			vals = append(vals, w)
+		}
+		n = max(n, src+1, dst+1)
+	}
+	if err = s.Err(); err != nil {
+		return
+	}
+	if options.N > 0 {
+		if n > options.N {
+			return nil, errors.New("edge list node id out of range")
+		}
+		n = options.N
+	}
+
+	defer GrB.CheckErrors(&err)
+	A, err := GrB.MatrixNew[D](n, n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = A.Free()
+		}
+	}()
+	dup := options.Dup
+	if dup == nil {
+		first := GrB.First[D, D]()
+		dup = &first
+	}
+	GrB.OK(A.Build(rows, cols, vals, dup))
+	return New(A, options.Kind), nil
+}
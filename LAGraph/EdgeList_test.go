@@ -0,0 +1,82 @@
+package LAGraph_test
+
+import (
+	"fmt"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"strings"
+	"testing"
+)
+
+func TestReadEdgeList(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	tryf := func(f func() error) {
+		try(f())
+	}
+
+	{
+		var sb strings.Builder
+		sb.WriteString("# Zachary karate club, upper triangle, 1-based\n")
+		for k, i := range zacharyI {
+			if j := zacharyJ[k]; i < j {
+				_, _ = fmt.Fprintf(&sb, "%v\t%v\n", i+1, j+1)
+			}
+		}
+		G, err := LAGraph.ReadEdgeList[uint32](strings.NewReader(sb.String()), LAGraph.EdgeListOptions[uint32]{
+			Kind: LAGraph.AdjacencyUndirected,
+		})
+		try(err)
+		defer tryf(G.Delete)
+		A, err := GrB.MatrixNew[uint32](zacharyNumNodes, zacharyNumNodes)
+		try(err)
+		defer tryf(A.Free)
+		try(A.Build(zacharyI, zacharyJ, zacharyV, nil))
+		ok, err := LAGraph.MatrixIsEqual(G.A, A)
+		try(err)
+		if !ok {
+			t.Error("karate edge list does not match")
+		}
+	}
+
+	{
+		const input = "% weighted, 0-based\n0 1 2.5\n1 2 1\n\n0 1 4\n2 0 3 extra\n"
+		plus := GrB.Plus[float64]()
+		G, err := LAGraph.ReadEdgeList[float64](strings.NewReader(input), LAGraph.EdgeListOptions[float64]{
+			ZeroBased: true,
+			Kind:      LAGraph.AdjacencyDirected,
+			Weighted:  true,
+			Dup:       &plus,
+			N:         4,
+		})
+		try(err)
+		defer tryf(G.Delete)
+		n, err := G.A.Nrows()
+		try(err)
+		if n != 4 {
+			t.Errorf("expected 4 nodes, got %v", n)
+		}
+		nvals, err := G.A.Nvals()
+		try(err)
+		if nvals != 3 {
+			t.Errorf("expected 3 edges, got %v", nvals)
+		}
+		w, ok, err := G.A.ExtractElement(0, 1)
+		try(err)
+		if !ok || w != 6.5 {
+			t.Errorf("expected duplicate edge weight 6.5, got %v", w)
+		}
+	}
+
+	{
+		_, err := LAGraph.ReadEdgeList[int](strings.NewReader("0 1\n"), LAGraph.EdgeListOptions[int]{
+			Kind: LAGraph.AdjacencyDirected,
+		})
+		if err == nil {
+			t.Error("expected error for node id 0 in 1-based edge list")
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+)
+
+// HITS computes the hub and authority scores of the nodes of G with the HITS
+// algorithm: in each iteration, the authority of a node becomes the sum of the hub
+// scores of the nodes that point to it, and then its hub score becomes the sum of the
+// authorities of the nodes it points to. Both scores are normalized to sum up to 1
+// in each iteration. The iteration stops when the sum of the absolute changes of
+// both scores, divided by 2, is at most tolerance. The values of G.A are ignored.
+func (G *Graph[D]) HITS(tolerance float32, iterMax int) (hubs, authorities GrB.Vector[float32], iterations int, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	GrB.OK(G.Check())
+	var AT GrB.Matrix[D]
+	if G.Kind == AdjacencyUndirected || G.IsSymmetricStructure == True {
+		AT = G.A
+	} else {
+		AT = G.AT
+		if !AT.Valid() {
+			err = errors.New("G.AT is required")
+			return
+		}
+	}
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	rdiff := float32(1)
+
+	h, err := GrB.VectorNew[float32](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = h.Free()
+		}
+	}()
+	a, err := GrB.VectorNew[float32](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = a.Free()
+		}
+	}()
+	hOld, err := GrB.VectorNew[float32](n)
+	GrB.OK(err)
+	defer func() {
+		try(hOld.Free)
+	}()
+	aOld, err := GrB.VectorNew[float32](n)
+	GrB.OK(err)
+	defer func() {
+		try(aOld.Free)
+	}()
+	t, err := GrB.VectorNew[float32](n)
+	GrB.OK(err)
+	defer try(t.Free)
+	GrB.OK(GrB.VectorAssignConstant(h, nil, nil, 1/float32(n), GrB.All(n), nil))
+	GrB.OK(GrB.VectorAssignConstant(a, nil, nil, 1/float32(n), GrB.All(n), nil))
+
+	plus := GrB.Plus[float32]()
+	minus := GrB.Minus[float32]()
+	plusMonoid := GrB.PlusMonoid[float32]()
+	plusSecond := PlusSecond[float32]()
+	normalize := func(x GrB.Vector[float32]) {
+		sum, e := GrB.VectorReduce(plusMonoid, x, nil)
+		GrB.OK(e)
+		if sum > 0 {
+			GrB.OK(GrB.VectorApplyBinaryOp2nd(x, nil, nil, GrB.Div[float32](), x, sum, nil))
+		}
+	}
+	difference := func(x, xOld GrB.Vector[float32]) float32 {
+		GrB.OK(GrB.VectorEWiseAddBinaryOp(t, nil, nil, minus, x, xOld, nil))
+		GrB.OK(GrB.VectorApply(t, nil, nil, GrB.Abs[float32](), t, nil))
+		diff, e := GrB.VectorReduce(plusMonoid, t, nil)
+		GrB.OK(e)
+		return diff
+	}
+
+	for iterations = 0; rdiff > tolerance; iterations++ {
+		if iterations >= iterMax {
+			err = ConvergenceFailure
+			return
+		}
+		h, hOld = hOld, h
+		a, aOld = aOld, a
+		// a = AT*hOld and h = A*a, with an explicit zero for every node
+		GrB.OK(GrB.VectorAssignConstant(a, nil, nil, 0, GrB.All(n), nil))
+		GrB.OK(GrB.MxV(a, nil, &plus, plusSecond, GrB.MatrixView[float32, D](AT), hOld, nil))
+		normalize(a)
+		GrB.OK(GrB.VectorAssignConstant(h, nil, nil, 0, GrB.All(n), nil))
+		GrB.OK(GrB.MxV(h, nil, &plus, plusSecond, GrB.MatrixView[float32, D](G.A), a, nil))
+		normalize(h)
+		rdiff = (difference(a, aOld) + difference(h, hOld)) / 2
+	}
+
+	hubs, authorities = h, a
+	return
+}
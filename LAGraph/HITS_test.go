@@ -0,0 +1,120 @@
+package LAGraph_test
+
+import (
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// referenceHITS iterates the hub and authority scores a fixed number of times
+func referenceHITS(n int, I, J []int, iterations int) (hubs, authorities []float64) {
+	h := make([]float64, n)
+	a := make([]float64, n)
+	for i := range n {
+		h[i] = 1 / float64(n)
+		a[i] = 1 / float64(n)
+	}
+	normalize := func(x []float64) {
+		sum := 0.0
+		for _, v := range x {
+			sum += v
+		}
+		if sum > 0 {
+			for i := range x {
+				x[i] /= sum
+			}
+		}
+	}
+	for range iterations {
+		a = make([]float64, n)
+		for e := range I {
+			a[J[e]] += h[I[e]]
+		}
+		normalize(a)
+		h = make([]float64, n)
+		for e := range I {
+			h[I[e]] += a[J[e]]
+		}
+		normalize(h)
+	}
+	return h, a
+}
+
+func checkScores(t *testing.T, name string, x GrB.Vector[float32], expected []float64) {
+	n := len(expected)
+	var I []int
+	var X []float32
+	if err := x.ExtractTuples(&I, &X); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(I) != n {
+		t.Errorf("%v has %v entries, expected %v", name, len(I), n)
+		return
+	}
+	for p, i := range I {
+		if math.Abs(float64(X[p])-expected[i]) > 1e-4 {
+			t.Errorf("%v(%v) is %v, expected %v", name, i, X[p], expected[i])
+			return
+		}
+	}
+}
+
+func TestHITS(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, test := range []struct {
+		aname string
+		kind  LAGraph.Kind
+	}{
+		{"karate.mtx", LAGraph.AdjacencyUndirected},
+		{"west0067.mtx", LAGraph.AdjacencyDirected},
+		{"ldbc-directed-example.mtx", LAGraph.AdjacencyDirected},
+		{"cover.mtx", LAGraph.AdjacencyDirected},
+	} {
+		f, err := os.Open(filepath.Join("testdata", test.aname))
+		try(err)
+		A, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		n, err := A.Nrows()
+		try(err)
+		var I, J []int
+		try(A.ExtractTuples(&I, &J, nil))
+		G := LAGraph.New(A, test.kind)
+
+		if test.kind == LAGraph.AdjacencyDirected {
+			if _, _, _, err = G.HITS(1e-6, 100); err == nil {
+				t.Errorf("%v: expected an error without G.AT", test.aname)
+			}
+			_, err = G.CachedAT()
+			try(err)
+		}
+
+		hubs, authorities, iterations, err := G.HITS(1e-6, 1000)
+		try(err)
+		expectedHubs, expectedAuthorities := referenceHITS(n, I, J, iterations)
+		checkScores(t, test.aname+" hubs", hubs, expectedHubs)
+		checkScores(t, test.aname+" authorities", authorities, expectedAuthorities)
+		for _, x := range []GrB.Vector[float32]{hubs, authorities} {
+			sum, err := GrB.VectorReduce(GrB.PlusMonoid[float32](), x, nil)
+			try(err)
+			if math.Abs(float64(sum)-1) > 1e-4 {
+				t.Errorf("%v: scores sum up to %v", test.aname, sum)
+			}
+			try(x.Free())
+		}
+
+		if _, _, _, err = G.HITS(1e-6, 1); err != LAGraph.ConvergenceFailure {
+			t.Errorf("%v: expected a convergence failure", test.aname)
+		}
+		try(G.Delete())
+	}
+}
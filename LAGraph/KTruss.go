@@ -0,0 +1,150 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+)
+
+func (G *Graph[D]) ktrussCheck() (err error) {
+	defer GrB.CheckErrors(&err)
+	GrB.OK(G.Check())
+	if G.NSelfEdges != 0 {
+		return errors.New("no self edges allowed")
+	}
+	if !(G.Kind == AdjacencyUndirected || (G.Kind == AdjacencyDirected && G.IsSymmetricStructure == True)) {
+		return errors.New("G.A must be known to be symmetric")
+	}
+	return
+}
+
+// ktrussLower returns the strictly lower triangular part of G.A, with all values 1.
+func (G *Graph[D]) ktrussLower() (L GrB.Matrix[int], err error) {
+	defer GrB.CheckErrors(&err)
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	L, err = GrB.MatrixNew[int](n, n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = L.Free()
+		}
+	}()
+	GrB.OK(GrB.MatrixSelect(L, nil, nil, GrB.Tril[int](), GrB.MatrixView[int, D](G.A), -1, nil))
+	GrB.OK(GrB.MatrixApply(L, nil, nil, GrB.One[int](), L, nil))
+	return
+}
+
+// ktruss reduces L, the strictly lower triangular part of a symmetric graph, to its
+// k-truss, removing edges with support below k-2 until no more edges are removed.
+// The support of each edge (i, j) of L, with j < i, is computed with the masked
+// Sandia products S<L> = L*U + L*L + U*L, where U = L', which count the triangles
+// (i, j, x) with x < j, j < x < i, and i < x, respectively. The support of the
+// edges of U follows by symmetry, so only half of it is computed.
+func ktruss(L GrB.Matrix[int], k int) (nvals int, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	n, err := L.Nrows()
+	GrB.OK(err)
+	U, err := GrB.MatrixNew[int](n, n)
+	GrB.OK(err)
+	defer try(U.Free)
+	S, err := GrB.MatrixNew[int](n, n)
+	GrB.OK(err)
+	defer try(S.Free)
+	semiring := GrB.PlusOneb[int]()
+	plus := GrB.Plus[int]()
+	ge := GrB.Valuege[int]()
+	lastNvals, err := L.Nvals()
+	GrB.OK(err)
+	for {
+		GrB.OK(GrB.Transpose(U, nil, nil, L, nil))
+		GrB.OK(GrB.MxM(S, L.AsMask(), nil, semiring, L, U, GrB.DescRS))
+		GrB.OK(GrB.MxM(S, L.AsMask(), &plus, semiring, L, L, GrB.DescS))
+		GrB.OK(GrB.MxM(S, L.AsMask(), &plus, semiring, U, L, GrB.DescS))
+		GrB.OK(GrB.MatrixSelect(L, nil, nil, ge, S, k-2, nil))
+		nvals, err = L.Nvals()
+		GrB.OK(err)
+		if nvals == lastNvals {
+			return
+		}
+		lastNvals = nvals
+	}
+}
+
+// symmetrize returns L + L'.
+func symmetrize(L GrB.Matrix[int]) (C GrB.Matrix[int], err error) {
+	defer GrB.CheckErrors(&err)
+	n, err := L.Nrows()
+	GrB.OK(err)
+	C, err = GrB.MatrixNew[int](n, n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = C.Free()
+		}
+	}()
+	plus := GrB.Plus[int]()
+	GrB.OK(GrB.Transpose(C, nil, nil, L, nil))
+	GrB.OK(GrB.MatrixAssign(C, nil, &plus, L, GrB.All(n), GrB.All(n), nil))
+	return
+}
+
+// KTruss computes the k-truss of G, the largest subgraph in which every edge is
+// part of at least k-2 triangles. The value of each edge of C is its support,
+// the number of triangles it is part of within the k-truss.
+func (G *Graph[D]) KTruss(k int) (C GrB.Matrix[int], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	if k < 3 {
+		err = errors.New("k must be 3 or more")
+		return
+	}
+	GrB.OK(G.ktrussCheck())
+	L, err := G.ktrussLower()
+	GrB.OK(err)
+	defer try(L.Free)
+	_, err = ktruss(L, k)
+	GrB.OK(err)
+	return symmetrize(L)
+}
+
+// AllKTruss computes the truss number of every edge of G, the largest k such that
+// the edge is part of the k-truss. nedges[k] is the number of undirected edges in
+// the k-truss, for all k up to the largest k with a non-empty k-truss.
+func (G *Graph[D]) AllKTruss() (truss GrB.Matrix[int], nedges []int, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	GrB.OK(G.ktrussCheck())
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+
+	L, err := G.ktrussLower()
+	GrB.OK(err)
+	defer try(L.Free)
+	T, err := GrB.MatrixNew[int](n, n)
+	GrB.OK(err)
+	defer try(T.Free)
+	GrB.OK(GrB.MatrixAssignConstant(T, L.AsMask(), nil, 2, GrB.All(n), GrB.All(n), GrB.DescS))
+
+	nvals, err := L.Nvals()
+	GrB.OK(err)
+	nedges = []int{nvals, nvals, nvals}
+	for k := 3; nvals > 0; k++ {
+		nvals, err = ktruss(L, k)
+		GrB.OK(err)
+		if nvals == 0 {
+			break
+		}
+		nedges = append(nedges, nvals)
+		GrB.OK(GrB.MatrixAssignConstant(T, L.AsMask(), nil, k, GrB.All(n), GrB.All(n), GrB.DescS))
+	}
+	truss, err = symmetrize(T)
+	GrB.OK(err)
+	return
+}
@@ -0,0 +1,131 @@
+package LAGraph_test
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type edge struct{ i, j int }
+
+// referenceKTruss peels the edges of a symmetric graph until every remaining edge
+// has a support of at least k-2, and returns the support of the remaining edges
+func referenceKTruss(n int, I, J []int, k int) map[edge]int {
+	adj := make([]map[int]bool, n)
+	for i := range adj {
+		adj[i] = make(map[int]bool)
+	}
+	for e := range I {
+		adj[I[e]][J[e]] = true
+	}
+	for {
+		support := make(map[edge]int)
+		for i := range n {
+			for j := range adj[i] {
+				for l := range adj[i] {
+					if adj[j][l] {
+						support[edge{i, j}]++
+					}
+				}
+			}
+		}
+		removed := false
+		for i := range n {
+			for j := range adj[i] {
+				if support[edge{i, j}] < k-2 {
+					delete(adj[i], j)
+					delete(adj[j], i)
+					removed = true
+				}
+			}
+		}
+		if !removed {
+			return support
+		}
+	}
+}
+
+func checkKTruss(C GrB.Matrix[int], expected map[edge]int) (err error) {
+	defer GrB.CheckErrors(&err)
+	var I, J, X []int
+	GrB.OK(C.ExtractTuples(&I, &J, &X))
+	if len(X) != len(expected) {
+		return errors.New("wrong number of edges in k-truss")
+	}
+	for e := range X {
+		if s, ok := expected[edge{I[e], J[e]}]; !ok || s != X[e] {
+			return errors.New("wrong k-truss support")
+		}
+	}
+	return
+}
+
+func TestKTruss(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, aname := range []string{"karate.mtx", "A.mtx", "ldbc-undirected-example.mtx", "ldbc-wcc-example.mtx", "tree-example.mtx"} {
+		f, err := os.Open(filepath.Join("testdata", aname))
+		try(err)
+		M, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		n, err := M.Nrows()
+		try(err)
+		var I, J []int
+		try(M.ExtractTuples(&I, &J, nil))
+		try(M.Free())
+		A, err := GrB.MatrixNew[int](n, n)
+		try(err)
+		try(A.Build(I, J, make([]int, len(I)), nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyUndirected)
+		try(G.DeleteSelfEdges())
+		try(G.A.ExtractTuples(&I, &J, nil))
+		try(G.CachedNSelfEdges())
+
+		truss, nedges, err := G.AllKTruss()
+		try(err)
+		var TI, TJ, TX []int
+		try(truss.ExtractTuples(&TI, &TJ, &TX))
+		for k := 3; k <= len(nedges); k++ {
+			expected := referenceKTruss(n, I, J, k)
+			C, err := G.KTruss(k)
+			try(err)
+			try(checkKTruss(C, expected))
+			try(C.Free())
+
+			if k < len(nedges) && nedges[k] != len(expected)/2 {
+				t.Errorf("%v: expected %v edges in the %v-truss, got %v", aname, len(expected)/2, k, nedges[k])
+			}
+			if k == len(nedges) && len(expected) != 0 {
+				t.Errorf("%v: expected an empty %v-truss", aname, k)
+			}
+			for e := range TX {
+				_, inTruss := expected[edge{TI[e], TJ[e]}]
+				if inTruss != (TX[e] >= k) {
+					t.Errorf("%v: wrong truss number of edge (%v, %v)", aname, TI[e], TJ[e])
+				}
+			}
+		}
+		try(truss.Free())
+		try(G.Delete())
+	}
+
+	{
+		A, err := GrB.MatrixNew[int](2, 2)
+		try(err)
+		try(A.Build([]int{0, 1}, []int{1, 0}, []int{1, 1}, nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyUndirected)
+		try(G.CachedNSelfEdges())
+		if _, err = G.KTruss(2); err == nil {
+			t.Error("expected an error for k < 3")
+		}
+		try(G.Delete())
+	}
+}
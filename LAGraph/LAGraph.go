@@ -705,16 +705,17 @@ func (G *Graph[D]) SortByDegree(byOut, ascending bool) (permutationVector []int,
 			p[i] = i
 		}
 	})
+	// the degree vector has no entries for nodes of degree 0, which keep d[i] == 0
 	var w0, w1 []int
 	GrB.OK(Degree.ExtractTuples(&w0, &w1))
 	if ascending {
-		parallel.Range(0, n, 0, func(low, high int) {
+		parallel.Range(0, len(w0), 0, func(low, high int) {
 			for i := low; i < high; i++ {
 				d[w0[i]] = w1[i]
 			}
 		})
 	} else {
-		parallel.Range(0, n, 0, func(low, high int) {
+		parallel.Range(0, len(w0), 0, func(low, high int) {
 			for i := low; i < high; i++ {
 				d[w0[i]] = -w1[i]
 			}
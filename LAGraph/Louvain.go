@@ -0,0 +1,248 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"math/rand"
+)
+
+// aggregate computes the community graph S'*A*S, where S is the membership matrix
+// with S(i, community[i]) = 1, and the communities are numbered from 0 to k-1.
+func aggregate(A GrB.Matrix[float64], community []int, k int) (C GrB.Matrix[float64], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	n := len(community)
+	index := make([]int, n)
+	for i := range index {
+		index[i] = i
+	}
+	S, err := GrB.MatrixNew[float64](n, k)
+	GrB.OK(err)
+	defer try(S.Free)
+	one, err := GrB.ScalarNew[float64]()
+	GrB.OK(err)
+	defer try(one.Free)
+	GrB.OK(one.SetElement(1))
+	GrB.OK(S.BuildScalar(index, community, one))
+
+	AS, err := GrB.MatrixNew[float64](n, k)
+	GrB.OK(err)
+	defer try(AS.Free)
+	plusTimes := GrB.PlusTimesSemiring[float64]()
+	GrB.OK(GrB.MxM(AS, nil, nil, plusTimes, A, S, nil))
+	C, err = GrB.MatrixNew[float64](k, k)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = C.Free()
+		}
+	}()
+	GrB.OK(GrB.MxM(C, nil, nil, plusTimes, S, AS, GrB.DescT0))
+	return
+}
+
+// aggregateModularity computes the modularity of a partition from its community
+// graph C: the sum over all communities c of C(c, c)/2m - resolution*(K(c)/2m)^2,
+// where K is the row sum of C and 2m the sum of all entries.
+func aggregateModularity(C GrB.Matrix[float64], resolution float64) (modularity float64, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	k, err := C.Nrows()
+	GrB.OK(err)
+	plus := GrB.PlusMonoid[float64]()
+	m2, err := GrB.MatrixReduce(plus, C, nil)
+	GrB.OK(err)
+	if m2 == 0 {
+		return
+	}
+
+	d, err := GrB.VectorNew[float64](k)
+	GrB.OK(err)
+	defer try(d.Free)
+	GrB.OK(d.ExtractDiag(C, 0, nil))
+	internal, err := GrB.VectorReduce(plus, d, nil)
+	GrB.OK(err)
+
+	GrB.OK(GrB.MatrixReduceMonoid(d, nil, nil, plus, C, nil))
+	GrB.OK(GrB.VectorEWiseMultBinaryOp(d, nil, nil, GrB.Times[float64](), d, d, nil))
+	squares, err := GrB.VectorReduce(plus, d, nil)
+	GrB.OK(err)
+
+	modularity = internal/m2 - resolution*squares/(m2*m2)
+	return
+}
+
+// louvainMove moves nodes between neighboring communities, in a random order, as long
+// as this increases the modularity. It returns the communities numbered from 0 to k-1,
+// and whether any node moved.
+func louvainMove(A GrB.Matrix[float64], resolution float64, rnd *rand.Rand) (community []int, k int, moved bool, err error) {
+	defer GrB.CheckErrors(&err)
+	n, err := A.Nrows()
+	GrB.OK(err)
+
+	var I, J []int
+	var X []float64
+	GrB.OK(A.ExtractTuples(&I, &J, &X))
+	ptr := make([]int, n+1)
+	for _, i := range I {
+		ptr[i+1]++
+	}
+	for i := range n {
+		ptr[i+1] += ptr[i]
+	}
+	adj := make([]int, len(J))
+	weight := make([]float64, len(X))
+	pos := make([]int, n)
+	copy(pos, ptr)
+	degree := make([]float64, n)
+	m2 := 0.0
+	for e, i := range I {
+		adj[pos[i]] = J[e]
+		weight[pos[i]] = X[e]
+		pos[i]++
+		degree[i] += X[e]
+		m2 += X[e]
+	}
+
+	community = make([]int, n)
+	total := make([]float64, n)
+	for i := range n {
+		community[i] = i
+		total[i] = degree[i]
+	}
+	if m2 == 0 {
+		return community, n, false, nil
+	}
+
+	// links[c] is the weight from the current node to community c, and
+	// neighbors lists the communities with a non-zero entry in links
+	links := make([]float64, n)
+	var neighbors []int
+	for changed := true; changed; {
+		changed = false
+		for _, i := range rnd.Perm(n) {
+			ci := community[i]
+			total[ci] -= degree[i]
+			neighbors = append(neighbors[:0], ci)
+			for p := ptr[i]; p < ptr[i+1]; p++ {
+				if j := adj[p]; j != i {
+					c := community[j]
+					if links[c] == 0 {
+						neighbors = append(neighbors, c)
+					}
+					links[c] += weight[p]
+				}
+			}
+			best := ci
+			bestGain := links[ci] - resolution*total[ci]*degree[i]/m2
+			for _, c := range neighbors[1:] {
+				if gain := links[c] - resolution*total[c]*degree[i]/m2; gain > bestGain {
+					best = c
+					bestGain = gain
+				}
+			}
+			for _, c := range neighbors {
+				links[c] = 0
+			}
+			total[best] += degree[i]
+			if best != ci {
+				community[i] = best
+				changed = true
+				moved = true
+			}
+		}
+	}
+
+	renumber := make([]int, n)
+	for i := range renumber {
+		renumber[i] = -1
+	}
+	for i, c := range community {
+		if renumber[c] < 0 {
+			renumber[c] = k
+			k++
+		}
+		community[i] = renumber[c]
+	}
+	return
+}
+
+// Louvain detects communities by maximizing the modularity with the Louvain method,
+// using the values of G.A as edge weights. Larger resolutions lead to smaller
+// communities. Each level moves nodes between communities and then aggregates each
+// community into a single node of the next level. levels[l] labels each node of G
+// with its community after level l, and community is the last of these levels.
+// The result only depends on G, resolution and seed.
+func (G *Graph[D]) Louvain(resolution float64, seed int64) (community GrB.Vector[int], modularity float64, levels []GrB.Vector[int], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+
+	GrB.OK(G.Check())
+	if !(G.Kind == AdjacencyUndirected || (G.Kind == AdjacencyDirected && G.IsSymmetricStructure == True)) {
+		err = errors.New("G.A must be known to be symmetric")
+		return
+	}
+	if resolution <= 0 {
+		err = errors.New("resolution must be positive")
+		return
+	}
+
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			for _, level := range levels {
+				_ = level.Free()
+			}
+			levels = nil
+			_ = community.Free()
+		}
+	}()
+
+	A, err := GrB.MatrixNew[float64](n, n)
+	GrB.OK(err)
+	defer func() {
+		try(A.Free)
+	}()
+	GrB.OK(GrB.MatrixAssign(A, nil, nil, GrB.MatrixView[float64, D](G.A), GrB.All(n), GrB.All(n), nil))
+
+	index := make([]int, n)
+	label := make([]int, n)
+	for i := range index {
+		index[i] = i
+		label[i] = i
+	}
+	rnd := rand.New(rand.NewSource(seed))
+	for {
+		c, k, moved, e := louvainMove(A, resolution, rnd)
+		GrB.OK(e)
+		if !moved {
+			break
+		}
+		for i := range label {
+			label[i] = c[label[i]]
+		}
+		level, e := GrB.VectorNew[int](n)
+		GrB.OK(e)
+		levels = append(levels, level)
+		GrB.OK(level.Build(index, label, nil))
+
+		C, e := aggregate(A, c, k)
+		GrB.OK(e)
+		GrB.OK(A.Free())
+		A = C
+	}
+
+	community, err = GrB.VectorNew[int](n)
+	GrB.OK(err)
+	GrB.OK(community.Build(index, label, nil))
+	modularity, err = aggregateModularity(A, resolution)
+	GrB.OK(err)
+	return
+}
@@ -0,0 +1,127 @@
+package LAGraph_test
+
+import (
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"math"
+	"testing"
+)
+
+// referenceModularity computes the modularity of a partition of a weighted symmetric graph
+func referenceModularity(n int, I, J []int, X []float64, label []int, resolution float64) float64 {
+	degree := make([]float64, n)
+	m2 := 0.0
+	internal := 0.0
+	for e := range I {
+		degree[I[e]] += X[e]
+		m2 += X[e]
+		if label[I[e]] == label[J[e]] {
+			internal += X[e]
+		}
+	}
+	total := make(map[int]float64)
+	for i := range n {
+		total[label[i]] += degree[i]
+	}
+	squares := 0.0
+	for _, k := range total {
+		squares += k * k
+	}
+	return internal/m2 - resolution*squares/(m2*m2)
+}
+
+func TestLouvain(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	{
+		G, err := setupTestTriangleCounts()
+		try(err)
+		X := make([]float64, len(zacharyI))
+		for e := range X {
+			X[e] = 1
+		}
+		for _, resolution := range []float64{0.5, 1, 2} {
+			community, modularity, levels, err := G.Louvain(resolution, 1)
+			try(err)
+			label, err := checkVector(community, zacharyNumNodes, -1)
+			try(err)
+			expected := referenceModularity(zacharyNumNodes, zacharyI, zacharyJ, X, label, resolution)
+			if math.Abs(modularity-expected) > 1e-9 {
+				t.Errorf("resolution %v: modularity %v, expected %v", resolution, modularity, expected)
+			}
+			if resolution == 1 && modularity < 0.38 {
+				t.Errorf("modularity %v of karate is too low", modularity)
+			}
+			if len(levels) == 0 {
+				t.Error("no levels")
+			} else {
+				ok, err := LAGraph.VectorIsEqual(community, levels[len(levels)-1])
+				try(err)
+				if !ok {
+					t.Error("community differs from the last level")
+				}
+			}
+			for l := 1; l < len(levels); l++ {
+				// every community of a level is a union of communities of the previous level
+				previous, err := checkVector(levels[l-1], zacharyNumNodes, -1)
+				try(err)
+				current, err := checkVector(levels[l], zacharyNumNodes, -1)
+				try(err)
+				merged := make(map[int]int)
+				for i := range zacharyNumNodes {
+					if c, ok := merged[previous[i]]; ok && c != current[i] {
+						t.Errorf("level %v splits a community of level %v", l, l-1)
+						break
+					}
+					merged[previous[i]] = current[i]
+				}
+			}
+
+			again, modularity2, levels2, err := G.Louvain(resolution, 1)
+			try(err)
+			ok, err := LAGraph.VectorIsEqual(community, again)
+			try(err)
+			if !ok || modularity != modularity2 {
+				t.Error("the same seed gives different results")
+			}
+
+			try(community.Free())
+			try(again.Free())
+			for _, level := range append(levels, levels2...) {
+				try(level.Free())
+			}
+		}
+		try(G.Delete())
+	}
+
+	{
+		// two triangles joined by a light edge
+		I := []int{0, 1, 0, 2, 1, 2, 3, 4, 3, 5, 4, 5, 2, 3}
+		J := []int{1, 0, 2, 0, 2, 1, 4, 3, 5, 3, 5, 4, 3, 2}
+		X := []float64{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0.5, 0.5}
+		A, err := GrB.MatrixNew[float64](6, 6)
+		try(err)
+		try(A.Build(I, J, X, nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyUndirected)
+		community, modularity, levels, err := G.Louvain(1, 3)
+		try(err)
+		label, err := checkVector(community, 6, -1)
+		try(err)
+		if label[0] != label[1] || label[0] != label[2] || label[3] != label[4] || label[3] != label[5] || label[0] == label[3] {
+			t.Errorf("unexpected communities %v", label)
+		}
+		expected := referenceModularity(6, I, J, X, label, 1)
+		if math.Abs(modularity-expected) > 1e-9 {
+			t.Errorf("modularity %v, expected %v", modularity, expected)
+		}
+		try(community.Free())
+		for _, level := range levels {
+			try(level.Free())
+		}
+		try(G.Delete())
+	}
+}
@@ -0,0 +1,56 @@
+package MatrixMarket
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"github.com/klauspost/compress/zstd"
+	"io"
+	"sync"
+)
+
+type decompressor struct {
+	magic      []byte
+	decompress func(io.Reader) (io.Reader, error)
+}
+
+var (
+	decompressorsMutex sync.RWMutex
+	decompressors      []decompressor
+)
+
+func init() {
+	RegisterDecompressor([]byte{0x1f, 0x8b}, func(r io.Reader) (io.Reader, error) {
+		return gzip.NewReader(r)
+	})
+	RegisterDecompressor([]byte{0x28, 0xb5, 0x2f, 0xfd}, func(r io.Reader) (io.Reader, error) {
+		d, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	})
+}
+
+// RegisterDecompressor registers a decompressor for input streams that start
+// with the given magic bytes. Decompressors registered later take precedence.
+// gzip and zstd are registered by default. If the returned reader implements
+// io.Closer, it is closed once the input has been read.
+func RegisterDecompressor(magic []byte, decompress func(io.Reader) (io.Reader, error)) {
+	decompressorsMutex.Lock()
+	defer decompressorsMutex.Unlock()
+	decompressors = append([]decompressor{{magic: bytes.Clone(magic), decompress: decompress}}, decompressors...)
+}
+
+func decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	decompressorsMutex.RLock()
+	ds := decompressors
+	decompressorsMutex.RUnlock()
+	for _, d := range ds {
+		if magic, err := br.Peek(len(d.magic)); err == nil && bytes.Equal(magic, d.magic) {
+			return d.decompress(br)
+		}
+	}
+	return br, nil
+}
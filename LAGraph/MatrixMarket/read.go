@@ -134,6 +134,42 @@ func (m *matrixConstructor[D]) addSkewSymmetric(row, col int, val string) {
 	m.vals = append(m.vals, v, -v)
 }
 
+func (m *matrixConstructor[D]) adder(storage storage) func(int, int, string) {
+	switch storage {
+	case general:
+		return m.addGeneral
+	case symmetric:
+		return m.addSymmetric
+	case skewSymmetric:
+		return m.addSkewSymmetric
+	}
+	panic("unreachable code")
+}
+
+// setter is like adder, but stores the k-th entry at a fixed position, so
+// that entries can be stored in parallel
+func (m *matrixConstructor[D]) setter(storage storage) func(int, int, int, string) {
+	switch storage {
+	case general:
+		return func(k, row, col int, val string) {
+			m.rows[k], m.cols[k], m.vals[k] = row, col, m.parseValue(val)
+		}
+	case symmetric:
+		return func(k, row, col int, val string) {
+			v := m.parseValue(val)
+			m.rows[2*k], m.cols[2*k], m.vals[2*k] = row, col, v
+			m.rows[2*k+1], m.cols[2*k+1], m.vals[2*k+1] = col, row, v
+		}
+	case skewSymmetric:
+		return func(k, row, col int, val string) {
+			v := m.parseValue(val)
+			m.rows[2*k], m.cols[2*k], m.vals[2*k] = row, col, v
+			m.rows[2*k+1], m.cols[2*k+1], m.vals[2*k+1] = col, row, -v
+		}
+	}
+	panic("unreachable code")
+}
+
 func (m *matrixConstructor[D]) constructMatrix() (A GrB.Matrix[D], err error) {
 	A, err = GrB.MatrixNew[D](m.nrows, m.ncols)
 	if err != nil {
@@ -154,6 +190,14 @@ func Read[D GrB.Number](r io.Reader) (matrix GrB.Matrix[D], err error) {
 	if err != nil {
 		return
 	}
+	return readNumber[D](hdr, s)
+}
+
+func readNumber[D GrB.Number](hdr header, s *bufio.Scanner) (matrix GrB.Matrix[D], err error) {
+	if hdr.typ == tcomplex {
+		err = errors.New("MatrixMarket complex type not supported by Read, use ReadComplex")
+		return
+	}
 	switch hdr.grbType {
 	case GrB.Int8:
 		return readDispatch[D, int8](hdr, s)
@@ -195,15 +239,7 @@ func read[D GrB.Number](hdr header, s *bufio.Scanner) (matrix GrB.Matrix[D], err
 		switch hdr.typ {
 		case treal, tinteger:
 			mc := makeMatrixConstructor[D](hdr.grbType, hdr.nrows, hdr.ncols, hdr.nvals, hdr.storage)
-			var addValue func(int, int, string)
-			switch hdr.storage {
-			case general:
-				addValue = mc.addGeneral
-			case symmetric:
-				addValue = mc.addSymmetric
-			case skewSymmetric:
-				addValue = mc.addSkewSymmetric
-			}
+			addValue := mc.adder(hdr.storage)
 			nvals := hdr.nvals
 			for s.Scan() {
 				sText := s.Text()
@@ -243,15 +279,7 @@ func read[D GrB.Number](hdr header, s *bufio.Scanner) (matrix GrB.Matrix[D], err
 			return mc.constructMatrix()
 		case tpattern:
 			mc := makeMatrixConstructor[D](hdr.grbType, hdr.nrows, hdr.ncols, hdr.nvals, hdr.storage)
-			var addValue func(int, int, string)
-			switch hdr.storage {
-			case general:
-				addValue = mc.addGeneral
-			case symmetric:
-				addValue = mc.addSymmetric
-			case skewSymmetric:
-				addValue = mc.addSkewSymmetric
-			}
+			addValue := mc.adder(hdr.storage)
 			nvals := hdr.nvals
 			for s.Scan() {
 				sText := s.Text()
@@ -295,18 +323,15 @@ func read[D GrB.Number](hdr header, s *bufio.Scanner) (matrix GrB.Matrix[D], err
 		switch hdr.typ {
 		case treal, tinteger:
 			mc := makeMatrixConstructor[D](hdr.grbType, hdr.nrows, hdr.ncols, hdr.nvals, hdr.storage)
-			var addValue func(int, int, string)
+			addValue := mc.adder(hdr.storage)
 			var row, col int
 			var resetRow func()
 			switch hdr.storage {
 			case general:
-				addValue = mc.addGeneral
 				resetRow = func() { row = 0 }
 			case symmetric:
-				addValue = mc.addSymmetric
 				resetRow = func() { row = col }
 			case skewSymmetric:
-				addValue = mc.addSkewSymmetric
 				resetRow = func() { row = col + 1 }
 			}
 			resetRow()
@@ -0,0 +1,241 @@
+package MatrixMarket
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"io"
+	"math/cmplx"
+	"strconv"
+	"strings"
+)
+
+type complexMatrixConstructor[D GrB.Complex] struct {
+	bits         int
+	nrows, ncols int
+	rows, cols   []int
+	vals         []D
+}
+
+func makeComplexMatrixConstructor[D GrB.Complex](kind GrB.Type, nrows, ncols, nvals int, storage storage) *complexMatrixConstructor[D] {
+	switch storage {
+	case symmetric, skewSymmetric, hermitian:
+		nvals *= 2
+	}
+	bits := 64
+	if kind == GrB.Complex64 {
+		bits = 32
+	}
+	return &complexMatrixConstructor[D]{
+		bits:  bits,
+		nrows: nrows,
+		ncols: ncols,
+		rows:  make([]int, 0, nvals),
+		cols:  make([]int, 0, nvals),
+		vals:  make([]D, 0, nvals),
+	}
+}
+
+func (m *complexMatrixConstructor[D]) parseValue(re, im string) D {
+	x, err := strconv.ParseFloat(re, m.bits)
+	if err != nil {
+		panic(fmt.Errorf("MatrixMarket value parse error %w while parsing %v", err, re))
+	}
+	y, err := strconv.ParseFloat(im, m.bits)
+	if err != nil {
+		panic(fmt.Errorf("MatrixMarket value parse error %w while parsing %v", err, im))
+	}
+	return D(complex(x, y))
+}
+
+func (m *complexMatrixConstructor[D]) addGeneral(row, col int, re, im string) {
+	m.rows = append(m.rows, row)
+	m.cols = append(m.cols, col)
+	m.vals = append(m.vals, m.parseValue(re, im))
+}
+
+func (m *complexMatrixConstructor[D]) addSymmetric(row, col int, re, im string) {
+	m.rows = append(m.rows, row, col)
+	m.cols = append(m.cols, col, row)
+	v := m.parseValue(re, im)
+	m.vals = append(m.vals, v, v)
+}
+
+func (m *complexMatrixConstructor[D]) addSkewSymmetric(row, col int, re, im string) {
+	m.rows = append(m.rows, row, col)
+	m.cols = append(m.cols, col, row)
+	v := m.parseValue(re, im)
+	m.vals = append(m.vals, v, -v)
+}
+
+func (m *complexMatrixConstructor[D]) addHermitian(row, col int, re, im string) {
+	m.rows = append(m.rows, row, col)
+	m.cols = append(m.cols, col, row)
+	v := m.parseValue(re, im)
+	m.vals = append(m.vals, v, D(cmplx.Conj(complex128(v))))
+}
+
+func (m *complexMatrixConstructor[D]) adder(storage storage) func(int, int, string, string) {
+	switch storage {
+	case general:
+		return m.addGeneral
+	case symmetric:
+		return m.addSymmetric
+	case skewSymmetric:
+		return m.addSkewSymmetric
+	case hermitian:
+		return m.addHermitian
+	}
+	panic("unreachable code")
+}
+
+func (m *complexMatrixConstructor[D]) constructMatrix() (A GrB.Matrix[D], err error) {
+	A, err = GrB.MatrixNew[D](m.nrows, m.ncols)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = A.Free()
+		}
+	}()
+	dup := GrB.First[D, D]()
+	err = A.Build(m.rows, m.cols, m.vals, &dup)
+	return
+}
+
+// ReadComplex reads a MatrixMarket file into a complex matrix. Files of
+// type real, integer, or pattern are accepted as well, and are converted
+// to complex values with a zero imaginary part.
+func ReadComplex[D GrB.Complex](r io.Reader) (matrix GrB.Matrix[D], err error) {
+	hdr, s, err := readHeader(r)
+	if err != nil {
+		return
+	}
+	if hdr.typ != tcomplex {
+		return readComplexFromNumber[D](hdr, s)
+	}
+	switch hdr.grbType {
+	case GrB.Complex64:
+		return readComplexDispatch[D, complex64](hdr, s)
+	case GrB.Complex128:
+		return readComplexDispatch[D, complex128](hdr, s)
+	default:
+		err = errors.New("MatrixMarket complex type requires a complex GraphBLAS type")
+		return
+	}
+}
+
+func readComplexFromNumber[D GrB.Complex](hdr header, s *bufio.Scanner) (matrix GrB.Matrix[D], err error) {
+	m, err := readNumber[float64](hdr, s)
+	if err != nil {
+		return
+	}
+	defer func() {
+		_ = m.Free()
+	}()
+	matrix, err = GrB.MatrixNew[D](hdr.nrows, hdr.ncols)
+	if err != nil {
+		return
+	}
+	if err = GrB.MatrixApply(matrix, nil, nil, GrB.Identity[D](), GrB.MatrixView[D, float64](m), nil); err != nil {
+		_ = matrix.Free()
+	}
+	return
+}
+
+func readComplexDispatch[To, From GrB.Complex](hdr header, s *bufio.Scanner) (matrix GrB.Matrix[To], err error) {
+	m, err := readComplex[From](hdr, s)
+	if err != nil {
+		return
+	}
+	matrix = GrB.MatrixView[To, From](m)
+	return
+}
+
+func readComplex[D GrB.Complex](hdr header, s *bufio.Scanner) (matrix GrB.Matrix[D], err error) {
+	mc := makeComplexMatrixConstructor[D](hdr.grbType, hdr.nrows, hdr.ncols, hdr.nvals, hdr.storage)
+	addValue := mc.adder(hdr.storage)
+	switch hdr.format {
+	case coordinate:
+		nvals := hdr.nvals
+		for s.Scan() {
+			sText := s.Text()
+			if strings.HasPrefix(sText, "%") {
+				continue
+			}
+			sText = strings.TrimSpace(sText)
+			if sText == "" {
+				continue
+			}
+			fields := strings.Fields(sText)
+			if len(fields) != 4 {
+				err = fmt.Errorf("MatrixMarket coordinate line unexpected number of elements, expected 4, got %v", len(fields))
+				return
+			}
+			row, e := strconv.ParseInt(fields[0], 10, 64)
+			if e != nil {
+				err = fmt.Errorf("MatrixMarket coordinate line row parse error %w, while parsing %v", e, fields[0])
+				return
+			}
+			col, e := strconv.ParseInt(fields[1], 10, 64)
+			if e != nil {
+				err = fmt.Errorf("MatrixMarket coordinate line col parse error %w, while parsing %v", e, fields[1])
+				return
+			}
+			if nvals == 0 {
+				err = errors.New("MatrixMarket too many coordinate lines")
+				return
+			}
+			addValue(int(row-1), int(col-1), fields[2], fields[3])
+			nvals--
+		}
+		if nvals > 0 {
+			err = errors.New("MatrixMarket too few coordinate lines")
+			return
+		}
+		return mc.constructMatrix()
+
+	case array:
+		var row, col int
+		var resetRow func()
+		switch hdr.storage {
+		case general:
+			resetRow = func() { row = 0 }
+		case symmetric, hermitian:
+			resetRow = func() { row = col }
+		case skewSymmetric:
+			resetRow = func() { row = col + 1 }
+		}
+		resetRow()
+		nrows := hdr.nrows
+		ncols := hdr.ncols
+		for s.Scan() {
+			sText := s.Text()
+			if strings.HasPrefix(sText, "%") {
+				continue
+			}
+			sText = strings.TrimSpace(sText)
+			if sText == "" {
+				continue
+			}
+			fields := strings.Fields(sText)
+			if len(fields) != 2 {
+				err = fmt.Errorf("MatrixMarket array line unexpected number of elements, expected 2, got %v", len(fields))
+				return
+			}
+			if row >= nrows || col >= ncols {
+				err = errors.New("MatrixMarket too many array lines")
+				return
+			}
+			addValue(row, col, fields[0], fields[1])
+			if row++; row == nrows {
+				col++
+				resetRow()
+			}
+		}
+		return mc.constructMatrix()
+	}
+	panic("unreachable code")
+}
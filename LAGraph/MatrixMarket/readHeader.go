@@ -115,8 +115,8 @@ func readHeader(r io.Reader) (hdr header, scanner *bufio.Scanner, err error) {
 		err = fmt.Errorf("MatrixMarket header line storage entry incorrect; expected (general | hermitian | symmetric | skew-symmetric), got %v", storageString)
 		return
 	}
-	if mmType == tcomplex || mmStorage == hermitian {
-		err = fmt.Errorf("MatrixMarket complex type currently not supported, got %v %v", typeString, storageString)
+	if mmStorage == hermitian && mmType != tcomplex {
+		err = fmt.Errorf("MatrixMarket hermitian storage requires complex type, got %v %v", typeString, storageString)
 		return
 	}
 
@@ -129,6 +129,8 @@ func readHeader(r io.Reader) (hdr header, scanner *bufio.Scanner, err error) {
 		grbType = GrB.Int64
 	case tpattern:
 		grbType = GrB.Int8
+	case tcomplex:
+		grbType = GrB.Complex128
 	}
 
 	if !s.Scan() {
@@ -169,10 +171,18 @@ func readHeader(r io.Reader) (hdr header, scanner *bufio.Scanner, err error) {
 			grbType = GrB.Float32
 		case "GrB_FP64":
 			grbType = GrB.Float64
+		case "GxB_FC32":
+			grbType = GrB.Complex64
+		case "GxB_FC64":
+			grbType = GrB.Complex128
 		default:
 			err = fmt.Errorf("MatrixMarket GraphBLAS type %v not supported or not known", entryType)
 			return
 		}
+		if (grbType == GrB.Complex64 || grbType == GrB.Complex128) && mmType != tcomplex {
+			err = fmt.Errorf("MatrixMarket GraphBLAS type %v requires complex type, got %v", entryType, typeString)
+			return
+		}
 		sText = ""
 	}
 
@@ -0,0 +1,270 @@
+package MatrixMarket
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/intel/forGoParallel/pipeline"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"io"
+	"math"
+	"strings"
+)
+
+const chunkSize = 1 << 22
+
+type chunk struct {
+	buf    []byte
+	lines  int
+	offset int
+}
+
+// ReadParallel reads a MatrixMarket file like Read, but splits the input
+// into chunks on line boundaries and parses the chunks in parallel. gzip
+// and zstd input is decompressed transparently.
+func ReadParallel[D GrB.Number](r io.Reader) (matrix GrB.Matrix[D], err error) {
+	dr, err := decompress(r)
+	if err != nil {
+		return
+	}
+	if c, ok := dr.(io.Closer); ok {
+		defer func() {
+			if e := c.Close(); err == nil {
+				err = e
+			}
+		}()
+	}
+	br := bufio.NewReaderSize(dr, 1<<16)
+	headerText, err := readHeaderText(br)
+	if err != nil {
+		return
+	}
+	hdr, _, err := readHeader(strings.NewReader(headerText))
+	if err != nil {
+		return
+	}
+	if hdr.format != coordinate || hdr.typ == tcomplex {
+		return readNumber[D](hdr, bufio.NewScanner(br))
+	}
+	switch hdr.grbType {
+	case GrB.Int8:
+		return readParallelDispatch[D, int8](hdr, br)
+	case GrB.Int16:
+		return readParallelDispatch[D, int16](hdr, br)
+	case GrB.Int32:
+		return readParallelDispatch[D, int32](hdr, br)
+	case GrB.Int64:
+		return readParallelDispatch[D, int64](hdr, br)
+	case GrB.Uint8:
+		return readParallelDispatch[D, uint8](hdr, br)
+	case GrB.Uint16:
+		return readParallelDispatch[D, uint16](hdr, br)
+	case GrB.Uint32:
+		return readParallelDispatch[D, uint32](hdr, br)
+	case GrB.Uint64:
+		return readParallelDispatch[D, uint64](hdr, br)
+	case GrB.Float32:
+		return readParallelDispatch[D, float32](hdr, br)
+	case GrB.Float64:
+		return readParallelDispatch[D, float64](hdr, br)
+	default:
+		panic("unreachable code")
+	}
+}
+
+// readHeaderText returns the header lines up to and including the size line,
+// leaving br positioned at the first entry line
+func readHeaderText(br *bufio.Reader) (string, error) {
+	var sb strings.Builder
+	for first := true; ; first = false {
+		line, err := br.ReadString('\n')
+		sb.WriteString(line)
+		if err == io.EOF {
+			return sb.String(), nil
+		} else if err != nil {
+			return "", err
+		}
+		if trimmed := strings.TrimSpace(line); !first && trimmed != "" && !strings.HasPrefix(trimmed, "%") {
+			return sb.String(), nil
+		}
+	}
+}
+
+func readParallelDispatch[To, From GrB.Number](hdr header, br *bufio.Reader) (matrix GrB.Matrix[To], err error) {
+	m, err := readParallel[From](hdr, br)
+	if err != nil {
+		return
+	}
+	matrix = GrB.MatrixView[To, From](m)
+	return
+}
+
+// readParallel first counts the entry lines of each chunk, then assigns the
+// chunks their offsets in input order, and finally parses the chunks in
+// parallel directly into their slots of the matrixConstructor.
+func readParallel[D GrB.Number](hdr header, br *bufio.Reader) (matrix GrB.Matrix[D], err error) {
+	mc := makeMatrixConstructor[D](hdr.grbType, hdr.nrows, hdr.ncols, hdr.nvals, hdr.storage)
+	mc.rows, mc.cols, mc.vals = mc.rows[:cap(mc.rows)], mc.cols[:cap(mc.cols)], mc.vals[:cap(mc.vals)]
+	setValue := mc.setter(hdr.storage)
+	nfields := 3
+	if hdr.typ == tpattern {
+		nfields = 2
+	}
+
+	source := pipeline.NewFunc[*chunk](nil, func(_ int) (data *chunk, fetched int, err error) {
+		buf := make([]byte, chunkSize)
+		n, err := io.ReadFull(br, buf)
+		switch err {
+		case nil:
+			if buf[n-1] != '\n' {
+				rest, e := br.ReadBytes('\n')
+				if e != nil && e != io.EOF {
+					return nil, 0, e
+				}
+				buf = append(buf, rest...)
+			}
+		case io.EOF:
+			return nil, 0, nil
+		case io.ErrUnexpectedEOF:
+			buf = buf[:n]
+			err = nil
+		default:
+			return nil, 0, err
+		}
+		return &chunk{buf: buf}, 1, nil
+	})
+
+	nvals := hdr.nvals
+	offset := 0
+	p := pipeline.New[*chunk](source)
+	p.Add(
+		pipeline.Par(pipeline.Receive(func(_ int, c *chunk) *chunk {
+			forEachLine(c.buf, func([]byte) { c.lines++ })
+			return c
+		})),
+		pipeline.Ord(pipeline.Receive(func(_ int, c *chunk) *chunk {
+			if c.lines > nvals {
+				p.SetErr(errors.New("MatrixMarket too many coordinate lines"))
+				return nil
+			}
+			nvals -= c.lines
+			c.offset = offset
+			offset += c.lines
+			return c
+		})),
+		pipeline.Par(pipeline.Receive(func(_ int, c *chunk) *chunk {
+			if c == nil {
+				return nil
+			}
+			if e := c.parse(setValue, nfields); e != nil {
+				p.SetErr(e)
+			}
+			return nil
+		})),
+	)
+	p.Run()
+	if err = p.Err(); err != nil {
+		return
+	}
+	if nvals > 0 {
+		err = errors.New("MatrixMarket too few coordinate lines")
+		return
+	}
+	return mc.constructMatrix()
+}
+
+// forEachLine calls f for each non-empty line in buf that is not a comment
+func forEachLine(buf []byte, f func(line []byte)) {
+	for len(buf) > 0 {
+		var line []byte
+		if i := bytes.IndexByte(buf, '\n'); i >= 0 {
+			line, buf = buf[:i], buf[i+1:]
+		} else {
+			line, buf = buf, nil
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '%' {
+			continue
+		}
+		f(line)
+	}
+}
+
+func (c *chunk) parse(setValue func(int, int, int, string), nfields int) (err error) {
+	defer func() {
+		if x := recover(); x != nil {
+			if e, ok := x.(error); ok {
+				err = e
+			} else {
+				panic(x)
+			}
+		}
+	}()
+	buf := c.buf
+	c.buf = nil
+	k := c.offset
+	forEachLine(buf, func(line []byte) {
+		var fields [3][]byte
+		n := 0
+		for field, rest := nextField(line); field != nil; field, rest = nextField(rest) {
+			if n == nfields {
+				n++
+				break
+			}
+			fields[n] = field
+			n++
+		}
+		if n != nfields {
+			panic(fmt.Errorf("MatrixMarket coordinate line unexpected number of elements, expected %v, got %v", nfields, len(bytes.Fields(line))))
+		}
+		row, e := parseIndex(fields[0])
+		if e != nil {
+			panic(fmt.Errorf("MatrixMarket coordinate line row parse error %w, while parsing %s", e, fields[0]))
+		}
+		col, e := parseIndex(fields[1])
+		if e != nil {
+			panic(fmt.Errorf("MatrixMarket coordinate line col parse error %w, while parsing %s", e, fields[1]))
+		}
+		val := "1"
+		if nfields == 3 {
+			val = string(fields[2])
+		}
+		setValue(k, row-1, col-1, val)
+		k++
+	})
+	return nil
+}
+
+func nextField(b []byte) (field, rest []byte) {
+	i := 0
+	for i < len(b) && (b[i] == ' ' || b[i] == '\t') {
+		i++
+	}
+	if i == len(b) {
+		return nil, nil
+	}
+	j := i
+	for j < len(b) && b[j] != ' ' && b[j] != '\t' {
+		j++
+	}
+	return b[i:j], b[j:]
+}
+
+func parseIndex(b []byte) (int, error) {
+	if len(b) == 0 {
+		return 0, errors.New("empty index")
+	}
+	var result int
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return 0, errors.New("invalid index syntax")
+		}
+		d := int(c - '0')
+		if result > (math.MaxInt-d)/10 {
+			return 0, errors.New("index out of range")
+		}
+		result = result*10 + d
+	}
+	return result, nil
+}
@@ -1,3 +1,307 @@
 package MatrixMarket
 
-// todo
+import (
+	"bufio"
+	"fmt"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"io"
+	"strconv"
+	"unsafe"
+)
+
+func (f format) String() string {
+	switch f {
+	case coordinate:
+		return coordinateString
+	case array:
+		return arrayString
+	}
+	panic("invalid MatrixMarket format")
+}
+
+func (t typ) String() string {
+	switch t {
+	case treal:
+		return realString
+	case tcomplex:
+		return complexString
+	case tpattern:
+		return patternString
+	case tinteger:
+		return integerString
+	}
+	panic("invalid MatrixMarket type")
+}
+
+func (s storage) String() string {
+	switch s {
+	case general:
+		return generalString
+	case hermitian:
+		return hermitianString
+	case symmetric:
+		return symmetricString
+	case skewSymmetric:
+		return skewSymmetricString
+	}
+	panic("invalid MatrixMarket storage")
+}
+
+func typeInfo[D GrB.Predefined]() (mmType typ, grbName string, bits int) {
+	var d D
+	switch any(d).(type) {
+	case bool:
+		return tinteger, "GrB_BOOL", 8
+	case int:
+		if unsafe.Sizeof(0) == 4 {
+			return tinteger, "GrB_INT32", 32
+		}
+		return tinteger, "GrB_INT64", 64
+	case int8:
+		return tinteger, "GrB_INT8", 8
+	case int16:
+		return tinteger, "GrB_INT16", 16
+	case int32:
+		return tinteger, "GrB_INT32", 32
+	case int64:
+		return tinteger, "GrB_INT64", 64
+	case uint:
+		if unsafe.Sizeof(0) == 4 {
+			return tinteger, "GrB_UINT32", 32
+		}
+		return tinteger, "GrB_UINT64", 64
+	case uint8:
+		return tinteger, "GrB_UINT8", 8
+	case uint16:
+		return tinteger, "GrB_UINT16", 16
+	case uint32:
+		return tinteger, "GrB_UINT32", 32
+	case uint64:
+		return tinteger, "GrB_UINT64", 64
+	case float32:
+		return treal, "GrB_FP32", 32
+	case float64:
+		return treal, "GrB_FP64", 64
+	}
+	panic("unreachable code")
+}
+
+func makeFormatValue[D GrB.Predefined](bits int) func(D) string {
+	return func(x D) string {
+		switch v := any(x).(type) {
+		case bool:
+			if v {
+				return "1"
+			}
+			return "0"
+		case int:
+			return strconv.FormatInt(int64(v), 10)
+		case int8:
+			return strconv.FormatInt(int64(v), 10)
+		case int16:
+			return strconv.FormatInt(int64(v), 10)
+		case int32:
+			return strconv.FormatInt(int64(v), 10)
+		case int64:
+			return strconv.FormatInt(v, 10)
+		case uint:
+			return strconv.FormatUint(uint64(v), 10)
+		case uint8:
+			return strconv.FormatUint(uint64(v), 10)
+		case uint16:
+			return strconv.FormatUint(uint64(v), 10)
+		case uint32:
+			return strconv.FormatUint(uint64(v), 10)
+		case uint64:
+			return strconv.FormatUint(v, 10)
+		case float32:
+			return strconv.FormatFloat(float64(v), 'g', -1, bits)
+		case float64:
+			return strconv.FormatFloat(v, 'g', -1, bits)
+		}
+		panic("unreachable code")
+	}
+}
+
+func one[D GrB.Predefined]() (result D) {
+	switch x := any(&result).(type) {
+	case *bool:
+		*x = true
+	case *int:
+		*x = 1
+	case *int8:
+		*x = 1
+	case *int16:
+		*x = 1
+	case *int32:
+		*x = 1
+	case *int64:
+		*x = 1
+	case *uint:
+		*x = 1
+	case *uint8:
+		*x = 1
+	case *uint16:
+		*x = 1
+	case *uint32:
+		*x = 1
+	case *uint64:
+		*x = 1
+	case *float32:
+		*x = 1
+	case *float64:
+		*x = 1
+	}
+	return
+}
+
+func isPattern[D GrB.Predefined](A GrB.Matrix[D], nrows, ncols, nvals int) (result bool, err error) {
+	defer GrB.CheckErrors(&err)
+	if nvals == 0 {
+		return false, nil
+	}
+	C, err := GrB.MatrixNew[D](nrows, ncols)
+	GrB.OK(err)
+	defer func() {
+		GrB.OK(C.Free())
+	}()
+	GrB.OK(GrB.MatrixSelect(C, nil, nil, GrB.Valueeq[D](), A, one[D](), nil))
+	cnvals, err := C.Nvals()
+	GrB.OK(err)
+	return cnvals == nvals, nil
+}
+
+func detectStorage[D GrB.Predefined](A, AT GrB.Matrix[D], n, nvals int) (mmStorage storage, err error) {
+	defer GrB.CheckErrors(&err)
+	C, err := GrB.MatrixNew[bool](n, n)
+	GrB.OK(err)
+	defer func() {
+		GrB.OK(C.Free())
+	}()
+	equal := func(B GrB.Matrix[D]) bool {
+		GrB.OK(GrB.MatrixEWiseMultBinaryOp(C, nil, nil, GrB.Eq[D](), A, B, nil))
+		cnvals, e := C.Nvals()
+		GrB.OK(e)
+		if cnvals != nvals {
+			return false
+		}
+		result, e := GrB.MatrixReduce(GrB.LandMonoidBool, C, nil)
+		GrB.OK(e)
+		return result
+	}
+	if equal(AT) {
+		return symmetric, nil
+	}
+	var d D
+	switch any(d).(type) {
+	case bool, uint, uint8, uint16, uint32, uint64:
+		return general, nil
+	}
+	d0, err := GrB.VectorNew[D](n)
+	GrB.OK(err)
+	defer func() {
+		GrB.OK(d0.Free())
+	}()
+	GrB.OK(d0.ExtractDiag(A, 0, nil))
+	ndiag, err := d0.Nvals()
+	GrB.OK(err)
+	if ndiag > 0 {
+		return general, nil
+	}
+	N, err := GrB.MatrixNew[D](n, n)
+	GrB.OK(err)
+	defer func() {
+		GrB.OK(N.Free())
+	}()
+	GrB.OK(GrB.MatrixApply(N, nil, nil, GrB.Ainv[D](), AT, nil))
+	if equal(N) {
+		return skewSymmetric, nil
+	}
+	return general, nil
+}
+
+func Write[D GrB.Predefined](w io.Writer, A GrB.Matrix[D]) (err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	nrows, ncols, err := A.Size()
+	GrB.OK(err)
+	nvals, err := A.Nvals()
+	GrB.OK(err)
+
+	mmType, grbName, bits := typeInfo[D]()
+	pattern, err := isPattern(A, nrows, ncols, nvals)
+	GrB.OK(err)
+	if pattern {
+		mmType = tpattern
+	}
+	mmFormat := coordinate
+	if !pattern && nvals == nrows*ncols {
+		mmFormat = array
+	}
+
+	AT, err := GrB.MatrixNew[D](ncols, nrows)
+	GrB.OK(err)
+	defer try(AT.Free)
+	GrB.OK(GrB.Transpose(AT, nil, nil, A, nil))
+
+	mmStorage := general
+	if nrows == ncols && nvals > 0 {
+		mmStorage, err = detectStorage(A, AT, nrows, nvals)
+		GrB.OK(err)
+	}
+
+	// rows of AT are columns of A, which yields the column-major order of the MatrixMarket format
+	switch mmStorage {
+	case symmetric:
+		GrB.OK(GrB.MatrixSelect(AT, nil, nil, GrB.Triu[D](), A, 0, GrB.DescT0))
+	case skewSymmetric:
+		GrB.OK(GrB.MatrixSelect(AT, nil, nil, GrB.Triu[D](), A, 1, GrB.DescT0))
+	}
+	GrB.OK(AT.Wait(GrB.Materialize))
+
+	var I, J []int
+	var X []D
+	GrB.OK(AT.ExtractTuples(&J, &I, &X))
+
+	bw := bufio.NewWriter(w)
+	pr := func(format string, a ...any) {
+		_, e := fmt.Fprintf(bw, format, a...)
+		GrB.OK(e)
+	}
+	pr("%%%%MatrixMarket matrix %v %v %v\n", mmFormat, mmType, mmStorage)
+	pr("%%%%GraphBLAS %v\n", grbName)
+	switch mmFormat {
+	case coordinate:
+		pr("%v %v %v\n", nrows, ncols, len(X))
+	case array:
+		pr("%v %v\n", nrows, ncols)
+	}
+
+	formatValue := makeFormatValue[D](bits)
+	for k, x := range X {
+		switch {
+		case mmFormat == array:
+			pr("%v\n", formatValue(x))
+		case pattern:
+			pr("%v %v\n", I[k]+1, J[k]+1)
+		default:
+			pr("%v %v %v\n", I[k]+1, J[k]+1, formatValue(x))
+		}
+	}
+	return bw.Flush()
+}
+
+func WriteVector[D GrB.Predefined](w io.Writer, v GrB.Vector[D]) (err error) {
+	defer GrB.CheckErrors(&err)
+	n, err := v.Size()
+	GrB.OK(err)
+	A, err := GrB.MatrixNew[D](n, 1)
+	GrB.OK(err)
+	defer func() {
+		GrB.OK(A.Free())
+	}()
+	GrB.OK(GrB.MatrixColAssign(A, nil, nil, v, GrB.All(n), 0, nil))
+	return Write(w, A)
+}
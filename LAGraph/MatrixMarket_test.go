@@ -0,0 +1,374 @@
+package LAGraph_test
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"github.com/klauspost/compress/zstd"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readTestMatrix[D GrB.Number](t *testing.T, aname string) GrB.Matrix[D] {
+	f, err := os.Open(filepath.Join("testdata", aname))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	A, err := MatrixMarket.Read[D](f)
+	if err != nil {
+		t.Fatalf("%v: %v", aname, err)
+	}
+	return A
+}
+
+// writeHeader returns the first line written by MatrixMarket.Write
+func writeHeader(t *testing.T, buf *bytes.Buffer) string {
+	line, err := bufio.NewReader(bytes.NewReader(buf.Bytes())).ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.TrimSpace(line)
+}
+
+func TestMatrixMarketWrite(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, test := range []struct {
+		aname, header string
+	}{
+		{"cover.mtx", "%%MatrixMarket matrix coordinate integer general"},
+		{"karate.mtx", "%%MatrixMarket matrix coordinate pattern symmetric"},
+		{"west0067.mtx", "%%MatrixMarket matrix coordinate real general"},
+		{"skew_fp64.mtx", "%%MatrixMarket matrix coordinate real skew-symmetric"},
+		{"skew_int32.mtx", "%%MatrixMarket matrix coordinate integer skew-symmetric"},
+		{"cover_structure.mtx", "%%MatrixMarket matrix coordinate pattern general"},
+		{"full.mtx", "%%MatrixMarket matrix array real general"},
+		{"full_symmetric.mtx", "%%MatrixMarket matrix array real symmetric"},
+	} {
+		A := readTestMatrix[float64](t, test.aname)
+		var buf bytes.Buffer
+		if strings.Contains(test.header, "integer") {
+			// write with an integer type, so that the MatrixMarket type is integer
+			n, m, err := A.Size()
+			try(err)
+			Ai, err := GrB.MatrixNew[int32](n, m)
+			try(err)
+			try(GrB.MatrixAssign(Ai, nil, nil, GrB.MatrixView[int32, float64](A), GrB.All(n), GrB.All(m), nil))
+			try(MatrixMarket.Write(&buf, Ai))
+			try(Ai.Free())
+		} else {
+			try(MatrixMarket.Write(&buf, A))
+		}
+		if header := writeHeader(t, &buf); header != test.header {
+			t.Errorf("%v: header is %q, expected %q", test.aname, header, test.header)
+		}
+		B, err := MatrixMarket.Read[float64](&buf)
+		try(err)
+		ok, err := LAGraph.MatrixIsEqual(A, B)
+		try(err)
+		if !ok {
+			t.Errorf("%v: written matrix differs from the original", test.aname)
+		}
+		try(A.Free())
+		try(B.Free())
+	}
+}
+
+func TestMatrixMarketWriteBool(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	toBool := func(M GrB.Matrix[int8]) GrB.Matrix[bool] {
+		n, m, err := M.Size()
+		try(err)
+		B, err := GrB.MatrixNew[bool](n, m)
+		try(err)
+		try(GrB.MatrixAssign(B, nil, nil, GrB.MatrixView[bool, int8](M), GrB.All(n), GrB.All(m), nil))
+		try(M.Free())
+		return B
+	}
+	A := toBool(readTestMatrix[int8](t, "matrix_bool.mtx"))
+	var buf bytes.Buffer
+	try(MatrixMarket.Write(&buf, A))
+	if header := writeHeader(t, &buf); header != "%%MatrixMarket matrix coordinate integer general" {
+		t.Errorf("matrix_bool.mtx: unexpected header %q", header)
+	}
+	if !strings.Contains(buf.String(), "%%GraphBLAS GrB_BOOL") {
+		t.Error("matrix_bool.mtx: missing GrB_BOOL type")
+	}
+	M, err := MatrixMarket.Read[int8](&buf)
+	try(err)
+	B := toBool(M)
+	ok, err := LAGraph.MatrixIsEqual(A, B)
+	try(err)
+	if !ok {
+		t.Error("matrix_bool.mtx: written matrix differs from the original")
+	}
+	try(A.Free())
+	try(B.Free())
+}
+
+func TestMatrixMarketWriteVector(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	A := readTestMatrix[float64](t, "west0067.mtx")
+	n, err := A.Nrows()
+	try(err)
+	for _, j := range []int{0, 5, n - 1} {
+		v, err := GrB.VectorNew[float64](n)
+		try(err)
+		try(GrB.MatrixColExtract(v, nil, nil, A, GrB.All(n), j, nil))
+		var buf bytes.Buffer
+		try(MatrixMarket.WriteVector(&buf, v))
+		B, err := MatrixMarket.Read[float64](&buf)
+		try(err)
+		nrows, ncols, err := B.Size()
+		try(err)
+		if nrows != n || ncols != 1 {
+			t.Errorf("column %v: written vector is %v x %v, expected %v x 1", j, nrows, ncols, n)
+		}
+		w, err := GrB.VectorNew[float64](n)
+		try(err)
+		try(GrB.MatrixColExtract(w, nil, nil, B, GrB.All(n), 0, nil))
+		ok, err := LAGraph.VectorIsEqual(v, w)
+		try(err)
+		if !ok {
+			t.Errorf("column %v: written vector differs from the original", j)
+		}
+		try(v.Free())
+		try(w.Free())
+		try(B.Free())
+	}
+	try(A.Free())
+}
+
+func TestReadComplex(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	check := func(name string, A GrB.Matrix[complex128], expected map[edge]complex128) {
+		var I, J []int
+		var X []complex128
+		try(A.ExtractTuples(&I, &J, &X))
+		if len(X) != len(expected) {
+			t.Errorf("%v: %v entries, expected %v", name, len(X), len(expected))
+		}
+		for k := range X {
+			if x, ok := expected[edge{I[k], J[k]}]; !ok || X[k] != x {
+				t.Errorf("%v: A(%v, %v) is %v, expected %v", name, I[k], J[k], X[k], x)
+			}
+		}
+	}
+
+	// complex.mtx is a dense 3-by-3 matrix in column-major order
+	f, err := os.Open(filepath.Join("testdata", "complex.mtx"))
+	try(err)
+	A, err := MatrixMarket.ReadComplex[complex128](f)
+	try(err)
+	try(f.Close())
+	values := []complex128{
+		complex(.646, .959), complex(.709, .340), complex(.754, .585),
+		complex(.276, .228), complex(.679, .751), complex(.655, .255),
+		complex(.162, .505), complex(.118, .699), complex(.498, .890),
+	}
+	expected := make(map[edge]complex128)
+	for k, x := range values {
+		expected[edge{k % 3, k / 3}] = x
+	}
+	check("complex.mtx", A, expected)
+	try(A.Free())
+
+	// only the lower triangle of a hermitian matrix is stored, and its diagonal is real
+	hermitian := `%%MatrixMarket matrix coordinate complex hermitian
+% a hermitian 3-by-3 matrix
+3 3 4
+1 1 2.0 0.0
+2 1 1.5 -0.5
+3 2 0.25 2.0
+3 3 -1.0 0.0
+`
+	A, err = MatrixMarket.ReadComplex[complex128](strings.NewReader(hermitian))
+	try(err)
+	check("hermitian", A, map[edge]complex128{
+		{0, 0}: 2,
+		{1, 0}: complex(1.5, -0.5),
+		{0, 1}: complex(1.5, 0.5),
+		{2, 1}: complex(0.25, 2),
+		{1, 2}: complex(0.25, -2),
+		{2, 2}: -1,
+	})
+	d, err := GrB.VectorNew[complex128](3)
+	try(err)
+	try(d.ExtractDiag(A, 0, nil))
+	var DX []complex128
+	try(d.ExtractTuples(nil, &DX))
+	for _, x := range DX {
+		if imag(x) != 0 {
+			t.Errorf("hermitian: diagonal entry %v is not real", x)
+		}
+	}
+	try(d.Free())
+	try(A.Free())
+
+	// real input is converted to complex values with a zero imaginary part
+	A, err = MatrixMarket.ReadComplex[complex128](strings.NewReader(`%%MatrixMarket matrix coordinate real symmetric
+2 2 2
+1 1 3.5
+2 1 -1
+`))
+	try(err)
+	check("real symmetric", A, map[edge]complex128{{0, 0}: 3.5, {1, 0}: -1, {0, 1}: -1})
+	try(A.Free())
+
+	// a complex GraphBLAS type is only valid for complex input
+	for _, entryType := range []string{"GxB_FC32", "GxB_FC64"} {
+		input := "%%MatrixMarket matrix coordinate real general\n%%GraphBLAS " + entryType + "\n2 2 1\n1 1 3.5\n"
+		if A, err := MatrixMarket.ReadComplex[complex128](strings.NewReader(input)); err == nil {
+			t.Errorf("%v on real input: expected an error from ReadComplex", entryType)
+			try(A.Free())
+		}
+		if B, err := MatrixMarket.Read[float64](strings.NewReader(input)); err == nil {
+			t.Errorf("%v on real input: expected an error from Read", entryType)
+			try(B.Free())
+		}
+	}
+}
+
+// randomMatrixMarket returns a MatrixMarket coordinate file with nvals random entries,
+// storing only the lower triangle unless the storage is general
+func randomMatrixMarket(n, nvals int, typ, storage string, seed int64) string {
+	rng := rand.New(rand.NewSource(seed))
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%%%%MatrixMarket matrix coordinate %v %v\n%% random entries\n%v %v %v\n", typ, storage, n, n, nvals)
+	for range nvals {
+		i, j := rng.Intn(n), rng.Intn(n)
+		if storage == "skew-symmetric" && i == j {
+			i = (j + 1) % n
+		}
+		if storage != "general" && i < j {
+			i, j = j, i
+		}
+		switch typ {
+		case "pattern":
+			fmt.Fprintf(&sb, "%v %v\n", i+1, j+1)
+		case "integer":
+			fmt.Fprintf(&sb, "%v %v %v\n", i+1, j+1, rng.Intn(1000)-500)
+		default:
+			fmt.Fprintf(&sb, "%v %v %v\n", i+1, j+1, rng.NormFloat64())
+		}
+	}
+	return sb.String()
+}
+
+func TestReadParallel(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	compare := func(name string, input []byte) {
+		A, err := MatrixMarket.Read[float64](bytes.NewReader(input))
+		try(err)
+		B, err := MatrixMarket.ReadParallel[float64](bytes.NewReader(input))
+		try(err)
+		ok, err := LAGraph.MatrixIsEqual(A, B)
+		try(err)
+		if !ok {
+			t.Errorf("%v: ReadParallel differs from Read", name)
+		}
+		try(A.Free())
+		try(B.Free())
+	}
+
+	for _, aname := range []string{"west0067.mtx", "karate.mtx", "skew_fp64.mtx", "cover_structure.mtx", "full.mtx", "lp_afiro_structure.mtx"} {
+		input, err := os.ReadFile(filepath.Join("testdata", aname))
+		try(err)
+		compare(aname, input)
+	}
+
+	// 500000 entries span several chunks of the input
+	for _, test := range []struct{ typ, storage string }{
+		{"real", "general"},
+		{"integer", "symmetric"},
+		{"pattern", "symmetric"},
+		{"real", "skew-symmetric"},
+	} {
+		input := []byte(randomMatrixMarket(10000, 500000, test.typ, test.storage, 42))
+		name := test.typ + " " + test.storage
+		compare(name, input)
+
+		var gz bytes.Buffer
+		w := gzip.NewWriter(&gz)
+		_, err := w.Write(input)
+		try(err)
+		try(w.Close())
+		A, err := MatrixMarket.Read[float64](bytes.NewReader(input))
+		try(err)
+		B, err := MatrixMarket.ReadParallel[float64](&gz)
+		try(err)
+		ok, err := LAGraph.MatrixIsEqual(A, B)
+		try(err)
+		if !ok {
+			t.Errorf("%v: gzip input differs from Read", name)
+		}
+		try(A.Free())
+		try(B.Free())
+
+		var zs bytes.Buffer
+		zw, err := zstd.NewWriter(&zs)
+		try(err)
+		_, err = zw.Write(input)
+		try(err)
+		try(zw.Close())
+		A, err = MatrixMarket.Read[float64](bytes.NewReader(input))
+		try(err)
+		B, err = MatrixMarket.ReadParallel[float64](&zs)
+		try(err)
+		ok, err = LAGraph.MatrixIsEqual(A, B)
+		try(err)
+		if !ok {
+			t.Errorf("%v: zstd input differs from Read", name)
+		}
+		try(A.Free())
+		try(B.Free())
+	}
+
+	// the number of entry lines must match the size line, also across chunks
+	input := randomMatrixMarket(10000, 500000, "real", "general", 7)
+	for _, test := range []struct{ name, input string }{
+		{"too many lines", strings.Replace(input, " 500000\n", " 499999\n", 1)},
+		{"too few lines", strings.Replace(input, " 500000\n", " 500001\n", 1)},
+		{"too many lines in a small file", "%%MatrixMarket matrix coordinate real general\n2 2 1\n1 1 1.0\n2 2 2.0\n"},
+		{"too few lines in a small file", "%%MatrixMarket matrix coordinate real general\n2 2 3\n1 1 1.0\n2 2 2.0\n"},
+	} {
+		if B, err := MatrixMarket.ReadParallel[float64](strings.NewReader(test.input)); err == nil {
+			t.Errorf("%v: expected an error", test.name)
+			try(B.Free())
+		}
+	}
+
+	if B, err := MatrixMarket.ReadParallel[float64](bytes.NewReader([]byte{0x28, 0xb5, 0x2f, 0xfd, 0, 0, 0, 0})); err == nil {
+		t.Error("corrupt zstd input: expected an error")
+		try(B.Free())
+	}
+}
@@ -0,0 +1,110 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"math/rand"
+)
+
+// MaximalIndependentSet computes a maximal independent set of G with a variant of
+// Luby's randomized algorithm. The result only depends on G, seed and ignoreNodes.
+// If ignoreNodes is valid, the nodes where it is true are excluded from the set.
+// Isolated nodes are always in the set. G.OutDegree is required.
+func (G *Graph[D]) MaximalIndependentSet(seed int64, ignoreNodes GrB.Vector[bool]) (iset GrB.Vector[bool], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+
+	GrB.OK(G.Check())
+	if !(G.Kind == AdjacencyUndirected || (G.Kind == AdjacencyDirected && G.IsSymmetricStructure == True)) {
+		err = errors.New("G.A must be known to be symmetric")
+		return
+	}
+	if G.NSelfEdges != 0 {
+		err = errors.New("no self edges allowed")
+		return
+	}
+	if !G.OutDegree.Valid() {
+		err = errors.New("G.OutDegree must be defined")
+		return
+	}
+
+	A := G.A
+	n, err := A.Nrows()
+	GrB.OK(err)
+
+	iset, err = GrB.VectorNew[bool](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = iset.Free()
+		}
+	}()
+	candidates, err := GrB.VectorNew[bool](n)
+	GrB.OK(err)
+	defer try(candidates.Free)
+	prob, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer try(prob.Free)
+	neighborMax, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer try(neighborMax.Free)
+	newMembers, err := GrB.VectorNew[bool](n)
+	GrB.OK(err)
+	defer try(newMembers.Free)
+	newNeighbors, err := GrB.VectorNew[bool](n)
+	GrB.OK(err)
+	defer try(newNeighbors.Free)
+
+	degree := make([]int, n)
+	{
+		var di, dx []int
+		GrB.OK(G.OutDegree.ExtractTuples(&di, &dx))
+		for k, i := range di {
+			degree[i] = dx[k]
+		}
+	}
+
+	// isolated nodes are in the set, all other nodes are candidates
+	GrB.OK(GrB.VectorAssignConstant(candidates, G.OutDegree.AsMask(), nil, true, GrB.All(n), GrB.DescS))
+	GrB.OK(GrB.VectorAssignConstant(iset, G.OutDegree.AsMask(), nil, true, GrB.All(n), GrB.DescSC))
+	if ignoreNodes.Valid() {
+		GrB.OK(GrB.VectorApply(candidates, &ignoreNodes, nil, GrB.Identity[bool](), candidates, GrB.DescRC))
+		GrB.OK(GrB.VectorApply(iset, &ignoreNodes, nil, GrB.Identity[bool](), iset, GrB.DescRC))
+	}
+
+	rnd := rand.New(rand.NewSource(seed))
+	maxSecond := GrB.MaxSecondSemiring[float64]()
+	Af := GrB.MatrixView[float64, D](A)
+	var cand []int
+	var score []float64
+	for {
+		ncandidates, e := candidates.Nvals()
+		GrB.OK(e)
+		if ncandidates == 0 {
+			break
+		}
+
+		// random scores, scaled by the inverse degree to favor low-degree nodes
+		GrB.OK(candidates.ExtractTuples(&cand, nil))
+		score = score[:0]
+		for _, i := range cand {
+			score = append(score, (0.0001+rnd.Float64())/(1+2*float64(degree[i])))
+		}
+		GrB.OK(prob.Clear())
+		GrB.OK(prob.Build(cand, score, nil))
+
+		// a candidate joins the set if its score exceeds that of all its candidate neighbors
+		GrB.OK(GrB.MxV(neighborMax, &candidates, nil, maxSecond, Af, prob, GrB.DescRS))
+		GrB.OK(GrB.VectorEWiseAddBinaryOp(newMembers, nil, nil, GrB.Gt[float64](), prob, neighborMax, nil))
+		GrB.OK(GrB.VectorSelect(newMembers, nil, nil, GrB.Valueeq[bool](), newMembers, true, nil))
+		GrB.OK(GrB.VectorAssignConstant(iset, newMembers.AsMask(), nil, true, GrB.All(n), GrB.DescS))
+
+		// new members and their neighbors are no longer candidates
+		GrB.OK(GrB.VectorApply(candidates, newMembers.AsMask(), nil, GrB.Identity[bool](), candidates, GrB.DescRSC))
+		GrB.OK(GrB.MxV(newNeighbors, &candidates, nil, GrB.AnyOneb[bool](), GrB.MatrixView[bool, D](A), newMembers, GrB.DescRS))
+		GrB.OK(GrB.VectorApply(candidates, newNeighbors.AsMask(), nil, GrB.Identity[bool](), candidates, GrB.DescRSC))
+	}
+	return
+}
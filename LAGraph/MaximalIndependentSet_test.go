@@ -0,0 +1,105 @@
+package LAGraph_test
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func checkMIS(iset GrB.Vector[bool], ignore []bool, n int, I, J []int) (err error) {
+	defer GrB.CheckErrors(&err)
+	member := make([]bool, n)
+	var mi []int
+	var mx []bool
+	GrB.OK(iset.ExtractTuples(&mi, &mx))
+	for k, i := range mi {
+		if !mx[k] {
+			return errors.New("independent set has an explicit false")
+		}
+		if ignore != nil && ignore[i] {
+			return errors.New("ignored node in independent set")
+		}
+		member[i] = true
+	}
+	covered := make([]bool, n)
+	for e := range I {
+		if member[I[e]] && member[J[e]] {
+			return errors.New("set is not independent")
+		}
+		if member[I[e]] {
+			covered[J[e]] = true
+		}
+	}
+	for i := range n {
+		if !member[i] && !covered[i] && (ignore == nil || !ignore[i]) {
+			return errors.New("set is not maximal")
+		}
+	}
+	return
+}
+
+func TestMaximalIndependentSet(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, aname := range []string{"karate.mtx", "A.mtx", "jagmesh7.mtx", "LFAT5_two.mtx", "bcsstk13.mtx", "tree-example.mtx"} {
+		f, err := os.Open(filepath.Join("testdata", aname))
+		try(err)
+		M, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		n, err := M.Nrows()
+		try(err)
+		var I, J []int
+		try(M.ExtractTuples(&I, &J, nil))
+		try(M.Free())
+		A, err := GrB.MatrixNew[bool](n, n)
+		try(err)
+		trues := make([]bool, len(I))
+		for e := range trues {
+			trues[e] = true
+		}
+		try(A.Build(I, J, trues, nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyUndirected)
+		try(G.DeleteSelfEdges())
+		try(G.A.ExtractTuples(&I, &J, nil))
+		try(G.CachedNSelfEdges())
+		try(G.CachedOutDegree())
+
+		for seed := range int64(4) {
+			iset, err := G.MaximalIndependentSet(seed, GrB.Vector[bool]{})
+			try(err)
+			try(checkMIS(iset, nil, n, I, J))
+			again, err := G.MaximalIndependentSet(seed, GrB.Vector[bool]{})
+			try(err)
+			ok, err := LAGraph.VectorIsEqual(iset, again)
+			try(err)
+			if !ok {
+				t.Errorf("%v: seed %v gives different results", aname, seed)
+			}
+			try(iset.Free())
+			try(again.Free())
+		}
+
+		ignore := make([]bool, n)
+		ignoreNodes, err := GrB.VectorNew[bool](n)
+		try(err)
+		for i := 0; i < n; i += 3 {
+			ignore[i] = true
+			try(ignoreNodes.SetElement(true, i))
+		}
+		try(ignoreNodes.SetElement(false, 1))
+		iset, err := G.MaximalIndependentSet(42, ignoreNodes)
+		try(err)
+		try(checkMIS(iset, ignore, n, I, J))
+		try(iset.Free())
+		try(ignoreNodes.Free())
+		try(G.Delete())
+	}
+}
@@ -0,0 +1,178 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"math"
+)
+
+func (G *Graph[D]) MinimumSpanningForest() (forest GrB.Matrix[D], weight D, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+
+	GrB.OK(G.Check())
+	if !(G.Kind == AdjacencyUndirected || (G.Kind == AdjacencyDirected && G.IsSymmetricStructure == True)) {
+		err = errors.New("G.A must be known to be symmetric")
+		return
+	}
+
+	A := G.A
+	n, err := A.Nrows()
+	GrB.OK(err)
+
+	var I, J []int
+	var X []D
+	{
+		U, e := GrB.MatrixNew[D](n, n)
+		GrB.OK(e)
+		defer try(U.Free)
+		GrB.OK(GrB.MatrixSelect(U, nil, nil, GrB.Triu[D](), A, 1, nil))
+		GrB.OK(U.ExtractTuples(&I, &J, &X))
+	}
+	m := len(X)
+
+	index := make([]int, max(n, m))
+	for i := range index {
+		index[i] = i
+	}
+
+	// rank the edges by weight, with ties broken by edge index, so that
+	// every component has a unique lightest edge
+	var perm []int
+	rank := make([]int, m)
+	{
+		w, e := GrB.VectorNew[D](m)
+		GrB.OK(e)
+		defer try(w.Free)
+		GrB.OK(w.Build(index[:m], X, nil))
+		p, e := GrB.VectorNew[int](m)
+		GrB.OK(e)
+		defer try(p.Free)
+		GrB.OK(w.Sort(nil, &p, GrB.Lt[D](), nil))
+		GrB.OK(p.ExtractTuples(nil, &perm))
+		for r, k := range perm {
+			rank[k] = r
+		}
+	}
+
+	R, err := GrB.MatrixNew[int](n, n)
+	GrB.OK(err)
+	defer try(R.Free)
+	GrB.OK(R.Build(append(I[:m:m], J...), append(J[:m:m], I...), append(rank[:m:m], rank...), nil))
+
+	ones, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(ones.Free)
+	GrB.OK(GrB.VectorAssignConstant(ones, nil, nil, 1, GrB.All(n), nil))
+	vmin, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(vmin.Free)
+	cmin, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(cmin.Free)
+	fv, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(fv.Free)
+	P, err := GrB.MatrixNew[bool](n, n)
+	GrB.OK(err)
+	defer try(P.Free)
+	Df, err := GrB.MatrixNew[int](n, n)
+	GrB.OK(err)
+	defer try(Df.Free)
+	T, err := GrB.MatrixNew[int](n, n)
+	GrB.OK(err)
+	defer try(T.Free)
+	M, err := GrB.MatrixNew[bool](n, n)
+	GrB.OK(err)
+	defer try(M.Free)
+	F, err := GrB.MatrixNew[bool](n, n)
+	GrB.OK(err)
+	defer try(F.Free)
+
+	minFirst := GrB.MinFirstSemiring[int]()
+	minSecond := GrB.MinSecondSemiring[int]()
+	anyFirst := GrB.AnyFirst[int]()
+	anyEq := GrB.AnyEq[int]()
+
+	f := make([]int, n)
+	copy(f, index)
+	trues := make([]bool, max(n, 2*m))
+	for i := range trues {
+		trues[i] = true
+	}
+	inForest := make([]bool, m)
+	var fi, fj []int
+	var fx []D
+
+	for {
+		nvals, e := R.Nvals()
+		GrB.OK(e)
+		if nvals == 0 {
+			break
+		}
+
+		// lightest remaining edge of each node, and then of each component
+		GrB.OK(GrB.MxV(vmin, nil, nil, minFirst, R, ones, nil))
+		GrB.OK(P.Clear())
+		GrB.OK(P.Build(f, index[:n], trues[:n], nil))
+		GrB.OK(GrB.MxV(cmin, nil, nil, minSecond, GrB.MatrixView[int, bool](P), vmin, nil))
+		var ranks []int
+		GrB.OK(cmin.ExtractTuples(nil, &ranks))
+		for _, r := range ranks {
+			if k := perm[r]; !inForest[k] {
+				inForest[k] = true
+				fi = append(fi, I[k])
+				fj = append(fj, J[k])
+				fx = append(fx, X[k])
+			}
+		}
+
+		// merge the components connected by forest edges, using FastSV
+		nf := len(fi)
+		GrB.OK(F.Clear())
+		GrB.OK(F.Build(append(fi[:nf:nf], fj...), append(fj[:nf:nf], fi...), trues[:2*nf], nil))
+		var component GrB.Vector[int]
+		if n > math.MaxInt32 {
+			component = connectedComponents[bool, int64, uint64](F, n, 2*nf)
+		} else {
+			component = connectedComponents[bool, int32, uint32](F, n, 2*nf)
+		}
+		e = component.ExtractTuples(nil, &f)
+		GrB.OK(component.Free())
+		GrB.OK(e)
+
+		// drop the edges within a component
+		GrB.OK(fv.Clear())
+		GrB.OK(fv.Build(index[:n], f, nil))
+		GrB.OK(Df.BuildDiag(fv, 0, nil))
+		GrB.OK(GrB.MxM(T, nil, nil, anyFirst, Df, R, nil))
+		GrB.OK(GrB.MxM(M, nil, nil, anyEq, T, Df, nil))
+		GrB.OK(GrB.MatrixApply(R, &M, nil, GrB.Identity[int](), R, GrB.DescRC))
+	}
+
+	nf := len(fi)
+	forest, err = GrB.MatrixNew[D](n, n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = forest.Free()
+		}
+	}()
+	GrB.OK(forest.Build(append(fi[:nf:nf], fj...), append(fj[:nf:nf], fi...), append(fx[:nf:nf], fx...), nil))
+
+	if nf > 0 {
+		w, e := GrB.VectorNew[D](nf)
+		GrB.OK(e)
+		defer try(w.Free)
+		GrB.OK(w.Build(index[:nf], fx, nil))
+		s, e := GrB.ScalarNew[D]()
+		GrB.OK(e)
+		defer try(s.Free)
+		GrB.OK(GrB.VectorReduceBinaryOpScalar(s, nil, GrB.Plus[D](), w, nil))
+		weight, _, err = s.ExtractElement()
+		GrB.OK(err)
+	}
+	return
+}
@@ -0,0 +1,125 @@
+package LAGraph_test
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// kruskal returns the weight and number of edges of a minimum spanning forest of a symmetric matrix
+func kruskal(A GrB.Matrix[int], n int) (weight, nedges int, err error) {
+	defer GrB.CheckErrors(&err)
+	var I, J []int
+	var X []int
+	GrB.OK(A.ExtractTuples(&I, &J, &X))
+	edges := make([]int, 0, len(X))
+	for k := range X {
+		if I[k] < J[k] {
+			edges = append(edges, k)
+		}
+	}
+	slices.SortStableFunc(edges, func(a, b int) int {
+		return X[a] - X[b]
+	})
+	parent := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for _, k := range edges {
+		if ri, rj := find(I[k]), find(J[k]); ri != rj {
+			parent[ri] = rj
+			weight += X[k]
+			nedges++
+		}
+	}
+	return
+}
+
+func checkMSF(forest GrB.Matrix[int], weight int, G *LAGraph.Graph[int]) (err error) {
+	defer GrB.CheckErrors(&err)
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	expectedWeight, expectedEdges, err := kruskal(G.A, n)
+	GrB.OK(err)
+	if weight != expectedWeight {
+		return errors.New("forest weight is not minimal")
+	}
+
+	var I, J []int
+	var X []int
+	GrB.OK(forest.ExtractTuples(&I, &J, &X))
+	if len(X) != 2*expectedEdges {
+		return errors.New("wrong number of forest edges")
+	}
+	sum := 0
+	for k := range X {
+		w, ok, e := G.A.ExtractElement(I[k], J[k])
+		GrB.OK(e)
+		if !ok || w != X[k] {
+			return errors.New("forest edge is not an edge of G")
+		}
+		wt, ok, e := forest.ExtractElement(J[k], I[k])
+		GrB.OK(e)
+		if !ok || wt != X[k] {
+			return errors.New("forest is not symmetric")
+		}
+		sum += X[k]
+	}
+	if sum != 2*weight {
+		return errors.New("forest weight does not match its edges")
+	}
+	return
+}
+
+func TestMinimumSpanningForest(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, aname := range []string{"msf1.mtx", "msf2.mtx", "msf3.mtx"} {
+		f, err := os.Open(filepath.Join("testdata", aname))
+		try(err)
+		A, err := MatrixMarket.Read[int](f)
+		try(err)
+		try(f.Close())
+		try(GrB.MatrixEWiseAddBinaryOp(A, nil, nil, GrB.Min[int](), A, A, GrB.DescT1))
+		G := LAGraph.New(A, LAGraph.AdjacencyUndirected)
+		forest, weight, err := G.MinimumSpanningForest()
+		try(err)
+		try(checkMSF(forest, weight, G))
+		try(forest.Free())
+		try(G.Delete())
+	}
+
+	{
+		A, err := GrB.MatrixNew[int](zacharyNumNodes, zacharyNumNodes)
+		try(err)
+		V := make([]int, len(zacharyV))
+		for k, v := range zacharyV {
+			V[k] = int(v)
+		}
+		try(A.Build(zacharyI, zacharyJ, V, nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyUndirected)
+		forest, weight, err := G.MinimumSpanningForest()
+		try(err)
+		if weight != zacharyNumNodes-1 {
+			t.Errorf("expected a spanning tree of weight %v, got %v", zacharyNumNodes-1, weight)
+		}
+		try(checkMSF(forest, weight, G))
+		try(forest.Free())
+		try(G.Delete())
+	}
+}
@@ -0,0 +1,159 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"math"
+)
+
+// MultiSourceBFS runs a breadth-first search from each of the given sources at once.
+// Row k of level and parent holds the result of BreadthFirstSearch(sources[k], ...).
+func (G *Graph[D]) MultiSourceBFS(sources []int, computeLevel, computeParent bool) (level, parent GrB.Matrix[int], err error) {
+	defer GrB.CheckErrors(&err)
+
+	GrB.OK(G.Check())
+
+	A := G.A
+	n, err := A.Nrows()
+	GrB.OK(err)
+
+	for _, src := range sources {
+		if src < 0 || src >= n {
+			err = errors.New("invalid source node")
+			return
+		}
+	}
+
+	if n > math.MaxInt32 {
+		l, p, e := multiSourceBFSDispatch[D, int64](G, n, sources, computeLevel, computeParent)
+		GrB.OK(e)
+		level = GrB.MatrixView[int, int64](l)
+		parent = GrB.MatrixView[int, int64](p)
+		return
+	}
+	l, p, err := multiSourceBFSDispatch[D, int32](G, n, sources, computeLevel, computeParent)
+	GrB.OK(err)
+	level = GrB.MatrixView[int, int32](l)
+	parent = GrB.MatrixView[int, int32](p)
+	return
+}
+
+func multiSourceBFSDispatch[D GrB.Predefined, Int int32 | int64](G *Graph[D], n int, sources []int, computeLevel, computeParent bool) (level, parent GrB.Matrix[Int], err error) {
+	if computeParent {
+		return multiSourceBFS[D, Int, Int](G, n, sources, computeLevel, computeParent, GrB.AnySecondi[Int]())
+	}
+	return multiSourceBFS[D, Int, bool](G, n, sources, computeLevel, computeParent, GrB.AnyOneb[bool]())
+}
+
+func multiSourceBFS[D GrB.Predefined, Int int32 | int64, Q int32 | int64 | bool](G *Graph[D], n int, sources []int, computeLevel, computeParent bool, semiring GrB.Semiring[Q, Q, Q]) (level, parent GrB.Matrix[Int], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+
+	A := GrB.MatrixView[Q, D](G.A)
+	var AT GrB.Matrix[Q]
+	if G.Kind == AdjacencyUndirected || (G.Kind == AdjacencyDirected && G.IsSymmetricStructure == True) {
+		AT = A
+	} else if G.AT.Valid() {
+		AT = GrB.MatrixView[Q, D](G.AT)
+	}
+	ns := len(sources)
+
+	q, err := GrB.MatrixNew[Q](ns, n)
+	GrB.OK(err)
+	defer try(q.Free)
+
+	var pi GrB.Matrix[Int]
+	if computeParent {
+		pi, err = GrB.MatrixNew[Int](ns, n)
+		GrB.OK(err)
+		defer func() {
+			if err != nil {
+				_ = pi.Free()
+			}
+		}()
+		GrB.OK(pi.SetSparsityControl(GrB.Bitmap + GrB.Full))
+	}
+
+	// the level matrix doubles as the visited mask if no parents are computed
+	var v GrB.Matrix[Int]
+	if computeLevel || !computeParent {
+		v, err = GrB.MatrixNew[Int](ns, n)
+		GrB.OK(err)
+		if computeLevel {
+			defer func() {
+				if err != nil {
+					_ = v.Free()
+				}
+			}()
+		} else {
+			defer try(v.Free)
+		}
+		GrB.OK(v.SetSparsityControl(GrB.Bitmap + GrB.Full))
+	}
+
+	for k, src := range sources {
+		if computeParent {
+			GrB.OK(pi.SetElement(Int(src), k, src))
+			GrB.OK(GrB.MatrixView[Int, Q](q).SetElement(Int(src), k, src))
+		} else {
+			GrB.OK(GrB.MatrixView[bool, Q](q).SetElement(true, k, src))
+		}
+		if v.Valid() {
+			GrB.OK(v.SetElement(0, k, src))
+		}
+	}
+
+	var mask *GrB.Matrix[bool]
+	if computeParent {
+		mask = pi.AsMask()
+	} else {
+		mask = v.AsMask()
+	}
+
+	nq, err := q.Nvals()
+	GrB.OK(err)
+	lastWasPull := false
+	for k := 1; nq > 0 && k < n; k++ {
+		frontierDensity := float64(nq) / float64(ns*n)
+		var doPull bool
+		if AT.Valid() {
+			if lastWasPull {
+				doPull = frontierDensity > 0.06
+			} else {
+				doPull = frontierDensity > 0.10
+			}
+		}
+
+		if doPull {
+			GrB.OK(q.SetSparsityControl(GrB.Bitmap))
+			GrB.OK(GrB.MxM(q, mask, nil, semiring, q, AT, GrB.DescRSCT1))
+		} else {
+			GrB.OK(q.SetSparsityControl(GrB.Sparse))
+			GrB.OK(GrB.MxM(q, mask, nil, semiring, q, A, GrB.DescRSC))
+		}
+		lastWasPull = doPull
+
+		nq, err = q.Nvals()
+		GrB.OK(err)
+		if nq == 0 {
+			break
+		}
+
+		if computeParent {
+			GrB.OK(GrB.MatrixAssign(pi, q.AsMask(), nil, GrB.MatrixView[Int, Q](q), GrB.All(ns), GrB.All(n), GrB.DescS))
+		}
+		if v.Valid() {
+			GrB.OK(GrB.MatrixAssignConstant(v, q.AsMask(), nil, Int(k), GrB.All(ns), GrB.All(n), GrB.DescS))
+		}
+	}
+
+	if computeParent {
+		parent = pi
+	}
+	if computeLevel {
+		level = v
+	}
+	return
+}
@@ -0,0 +1,112 @@
+package LAGraph_test
+
+import (
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func checkMultiSourceBFS[D GrB.Predefined](level, parent *GrB.Matrix[int], G *LAGraph.Graph[D], sources []int) (err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	l, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(l.Free)
+	p, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(p.Free)
+	for k, src := range sources {
+		var lp, pp *GrB.Vector[int]
+		if level != nil {
+			GrB.OK(GrB.MatrixColExtract(l, nil, nil, *level, GrB.All(n), k, GrB.DescT0))
+			lp = &l
+		}
+		if parent != nil {
+			GrB.OK(GrB.MatrixColExtract(p, nil, nil, *parent, GrB.All(n), k, GrB.DescT0))
+			pp = &p
+		}
+		GrB.OK(checkBFS(lp, pp, G, src))
+	}
+	return
+}
+
+func TestMultiSourceBFSKarate(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	G, err := setupTestBreadthFirstSearch()
+	try(err)
+	defer func() {
+		try(G.Delete())
+	}()
+	sources := []int{testBFSSrc, 0, 33, testBFSSrc}
+	level, parent, err := G.MultiSourceBFS(sources, true, true)
+	try(err)
+	defer func() {
+		try(level.Free())
+		try(parent.Free())
+	}()
+	try(checkMultiSourceBFS(&level, &parent, G, sources))
+
+	l, err := GrB.VectorNew[int](zacharyNumNodes)
+	try(err)
+	defer func() {
+		try(l.Free())
+	}()
+	try(GrB.MatrixColExtract(l, nil, nil, level, GrB.All(zacharyNumNodes), 3, GrB.DescT0))
+	ok, err := checkKarateLevels30(l)
+	try(err)
+	if !ok {
+		t.Error("incorrect levels")
+	}
+}
+
+func TestMultiSourceBFSMany(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, name := range []string{"ldbc-directed-example.mtx", "olm1000.mtx", "cryg2500.mtx", "west0067.mtx", "ldbc-undirected-example.mtx"} {
+		f, err := os.Open(filepath.Join("testdata", name))
+		try(err)
+		A, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		G := LAGraph.New(A, LAGraph.AdjacencyDirected)
+		n, err := A.Nrows()
+		try(err)
+		var sources []int
+		for src := 0; src < n; src += n/8 + 1 {
+			sources = append(sources, src)
+		}
+		for range 2 {
+			level, parent, err := G.MultiSourceBFS(sources, true, true)
+			try(err)
+			try(checkMultiSourceBFS(&level, &parent, G, sources))
+			try(level.Free())
+			try(parent.Free())
+			level, _, err = G.MultiSourceBFS(sources, true, false)
+			try(err)
+			try(checkMultiSourceBFS(&level, nil, G, sources))
+			try(level.Free())
+			_, parent, err = G.MultiSourceBFS(sources, false, true)
+			try(err)
+			try(checkMultiSourceBFS(nil, &parent, G, sources))
+			try(parent.Free())
+
+			_, err = G.CachedAT()
+			try(err)
+		}
+		try(G.Delete())
+	}
+}
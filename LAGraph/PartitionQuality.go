@@ -0,0 +1,157 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"slices"
+)
+
+// PartitionQuality describes how well a labeling partitions an undirected graph.
+// The communities are numbered from 0 to k-1 in ascending order of their labels.
+type PartitionQuality struct {
+	Labels        []int     // label of each community
+	Size          []int     // number of nodes in each community
+	InternalEdges []int     // number of edges within each community
+	CutEdges      []int     // number of edges between each community and the others
+	Conductance   []float64 // cut edges of each community divided by the smaller of its volume and that of the rest
+	Coverage      float64   // fraction of edges within communities
+	Performance   float64   // fraction of node pairs that are edges within or non-edges between communities
+}
+
+// communities maps the labels of all n nodes to communities numbered from 0 to k-1.
+func communities(labels GrB.Vector[int], n int) (community []int, communityLabels []int, err error) {
+	defer GrB.CheckErrors(&err)
+	size, err := labels.Size()
+	GrB.OK(err)
+	nvals, err := labels.Nvals()
+	GrB.OK(err)
+	if size != n || nvals != n {
+		err = errors.New("every node must have a label")
+		return
+	}
+	var li []int
+	GrB.OK(labels.ExtractTuples(&li, &community))
+	communityLabels = slices.Clone(community)
+	slices.Sort(communityLabels)
+	communityLabels = slices.Compact(communityLabels)
+	for i, l := range community {
+		community[i], _ = slices.BinarySearch(communityLabels, l)
+	}
+	return
+}
+
+func (G *Graph[D]) partitionCheck() (err error) {
+	defer GrB.CheckErrors(&err)
+	GrB.OK(G.Check())
+	if !(G.Kind == AdjacencyUndirected || (G.Kind == AdjacencyDirected && G.IsSymmetricStructure == True)) {
+		err = errors.New("G.A must be known to be symmetric")
+	}
+	return
+}
+
+// Modularity computes the modularity of the partition of G given by labels. If
+// weighted is true, the values of G.A are the edge weights, otherwise every edge
+// has weight 1. The resolution is 1 for the standard definition of modularity.
+func (G *Graph[D]) Modularity(labels GrB.Vector[int], weighted bool, resolution float64) (modularity float64, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	GrB.OK(G.partitionCheck())
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	community, communityLabels, err := communities(labels, n)
+	GrB.OK(err)
+
+	A, err := GrB.MatrixNew[float64](n, n)
+	GrB.OK(err)
+	defer try(A.Free)
+	if weighted {
+		GrB.OK(GrB.MatrixAssign(A, nil, nil, GrB.MatrixView[float64, D](G.A), GrB.All(n), GrB.All(n), nil))
+	} else {
+		GrB.OK(GrB.MatrixApply(A, nil, nil, GrB.One[float64](), GrB.MatrixView[float64, D](G.A), nil))
+	}
+	C, err := aggregate(A, community, len(communityLabels))
+	GrB.OK(err)
+	defer try(C.Free)
+	return aggregateModularity(C, resolution)
+}
+
+// PartitionQuality computes the coverage, performance and conductance of the
+// partition of G given by labels, counting every undirected edge once and
+// ignoring self edges.
+func (G *Graph[D]) PartitionQuality(labels GrB.Vector[int]) (quality PartitionQuality, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	GrB.OK(G.partitionCheck())
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	community, communityLabels, err := communities(labels, n)
+	GrB.OK(err)
+	k := len(communityLabels)
+
+	A, err := GrB.MatrixNew[float64](n, n)
+	GrB.OK(err)
+	defer try(A.Free)
+	GrB.OK(GrB.MatrixSelect(A, nil, nil, GrB.Offdiag[float64](), GrB.MatrixView[float64, D](G.A), 0, nil))
+	GrB.OK(GrB.MatrixApply(A, nil, nil, GrB.One[float64](), A, nil))
+	m2, err := A.Nvals()
+	GrB.OK(err)
+	C, err := aggregate(A, community, k)
+	GrB.OK(err)
+	defer try(C.Free)
+
+	volume, err := GrB.VectorNew[float64](k)
+	GrB.OK(err)
+	defer try(volume.Free)
+	GrB.OK(GrB.MatrixReduceMonoid(volume, nil, nil, GrB.PlusMonoid[float64](), C, nil))
+	vol := make([]float64, k)
+	var ci []int
+	var cx []float64
+	GrB.OK(volume.ExtractTuples(&ci, &cx))
+	for p, c := range ci {
+		vol[c] = cx[p]
+	}
+	within := make([]float64, k)
+	GrB.OK(volume.ExtractDiag(C, 0, nil))
+	GrB.OK(volume.ExtractTuples(&ci, &cx))
+	for p, c := range ci {
+		within[c] = cx[p]
+	}
+
+	quality = PartitionQuality{
+		Labels:        communityLabels,
+		Size:          make([]int, k),
+		InternalEdges: make([]int, k),
+		CutEdges:      make([]int, k),
+		Conductance:   make([]float64, k),
+	}
+	for _, c := range community {
+		quality.Size[c]++
+	}
+	internal, cut := 0, 0
+	pairsWithin := 0
+	for c := range k {
+		quality.InternalEdges[c] = int(within[c]) / 2
+		quality.CutEdges[c] = int(vol[c] - within[c])
+		internal += quality.InternalEdges[c]
+		cut += quality.CutEdges[c]
+		pairsWithin += quality.Size[c] * (quality.Size[c] - 1) / 2
+		if d := min(vol[c], float64(m2)-vol[c]); d > 0 {
+			quality.Conductance[c] = float64(quality.CutEdges[c]) / d
+		}
+	}
+	cut /= 2
+
+	m := m2 / 2
+	if m > 0 {
+		quality.Coverage = float64(internal) / float64(m)
+	}
+	if pairs := n * (n - 1) / 2; pairs > 0 {
+		pairsBetween := pairs - pairsWithin
+		quality.Performance = float64(internal+pairsBetween-cut) / float64(pairs)
+	}
+	return
+}
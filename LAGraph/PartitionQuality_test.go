@@ -0,0 +1,176 @@
+package LAGraph_test
+
+import (
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestPartitionQuality(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	G, err := setupTestTriangleCounts()
+	try(err)
+	n := zacharyNumNodes
+	ones := make([]float64, len(zacharyI))
+	weights := make([]float64, len(zacharyI))
+	for e := range zacharyI {
+		ones[e] = 1
+		weights[e] = float64(zacharyV[e])
+	}
+
+	cc, err := G.ConnectedComponents()
+	try(err)
+	cdlp, err := G.CDLP(100)
+	try(err)
+	louvain, _, levels, err := G.Louvain(1, 1)
+	try(err)
+	for _, level := range levels {
+		try(level.Free())
+	}
+	halves, err := GrB.VectorNew[int](n)
+	try(err)
+	for i := range n {
+		try(halves.SetElement(i*2/n*10, i))
+	}
+
+	for _, labels := range []GrB.Vector[int]{cc, cdlp, louvain, halves} {
+		label, err := checkVector(labels, n, -1)
+		try(err)
+
+		for _, resolution := range []float64{0.5, 1} {
+			modularity, err := G.Modularity(labels, false, resolution)
+			try(err)
+			if expected := referenceModularity(n, zacharyI, zacharyJ, ones, label, resolution); math.Abs(modularity-expected) > 1e-9 {
+				t.Errorf("unweighted modularity %v, expected %v", modularity, expected)
+			}
+			modularity, err = G.Modularity(labels, true, resolution)
+			try(err)
+			if expected := referenceModularity(n, zacharyI, zacharyJ, weights, label, resolution); math.Abs(modularity-expected) > 1e-9 {
+				t.Errorf("weighted modularity %v, expected %v", modularity, expected)
+			}
+		}
+
+		quality, err := G.PartitionQuality(labels)
+		try(err)
+		communityLabels := slices.Clone(label)
+		slices.Sort(communityLabels)
+		communityLabels = slices.Compact(communityLabels)
+		if !slices.Equal(quality.Labels, communityLabels) {
+			t.Errorf("labels %v, expected %v", quality.Labels, communityLabels)
+			continue
+		}
+		k := len(communityLabels)
+		community := make([]int, n)
+		for i := range n {
+			community[i], _ = slices.BinarySearch(communityLabels, label[i])
+		}
+		size := make([]int, k)
+		for _, c := range community {
+			size[c]++
+		}
+		internal := make([]int, k)
+		cut := make([]int, k)
+		volume := make([]int, k)
+		m, totalInternal, totalCut := 0, 0, 0
+		for e := range zacharyI {
+			ci, cj := community[zacharyI[e]], community[zacharyJ[e]]
+			volume[ci]++
+			if zacharyI[e] < zacharyJ[e] {
+				m++
+				if ci == cj {
+					internal[ci]++
+					totalInternal++
+				} else {
+					cut[ci]++
+					cut[cj]++
+					totalCut++
+				}
+			}
+		}
+		if !slices.Equal(quality.Size, size) || !slices.Equal(quality.InternalEdges, internal) || !slices.Equal(quality.CutEdges, cut) {
+			t.Errorf("sizes %v, internal edges %v, cut edges %v, expected %v, %v, %v",
+				quality.Size, quality.InternalEdges, quality.CutEdges, size, internal, cut)
+		}
+		for c := range k {
+			expected := 0.0
+			if d := min(volume[c], 2*m-volume[c]); d > 0 {
+				expected = float64(cut[c]) / float64(d)
+			}
+			if math.Abs(quality.Conductance[c]-expected) > 1e-9 {
+				t.Errorf("conductance of community %v is %v, expected %v", c, quality.Conductance[c], expected)
+			}
+		}
+		if expected := float64(totalInternal) / float64(m); math.Abs(quality.Coverage-expected) > 1e-9 {
+			t.Errorf("coverage %v, expected %v", quality.Coverage, expected)
+		}
+		pairs := n * (n - 1) / 2
+		pairsWithin := 0
+		for _, s := range size {
+			pairsWithin += s * (s - 1) / 2
+		}
+		if expected := float64(totalInternal+pairs-pairsWithin-totalCut) / float64(pairs); math.Abs(quality.Performance-expected) > 1e-9 {
+			t.Errorf("performance %v, expected %v", quality.Performance, expected)
+		}
+	}
+
+	// a single community covers every edge
+	quality, err := G.PartitionQuality(cc)
+	try(err)
+	if len(quality.Labels) != 1 || quality.Coverage != 1 {
+		t.Errorf("unexpected quality %v of the connected components of karate", quality)
+	}
+
+	try(halves.RemoveElement(0))
+	if _, err = G.Modularity(halves, false, 1); err == nil {
+		t.Error("expected an error for a node without a label")
+	}
+	if _, err = G.PartitionQuality(halves); err == nil {
+		t.Error("expected an error for a node without a label")
+	}
+
+	for _, labels := range []GrB.Vector[int]{cc, cdlp, louvain, halves} {
+		try(labels.Free())
+	}
+	try(G.Delete())
+
+	{
+		// two triangles joined by a light edge
+		I := []int{0, 1, 0, 2, 1, 2, 3, 4, 3, 5, 4, 5, 2, 3}
+		J := []int{1, 0, 2, 0, 2, 1, 4, 3, 5, 3, 5, 4, 3, 2}
+		X := []float64{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0.5, 0.5}
+		ones := make([]float64, len(X))
+		for e := range ones {
+			ones[e] = 1
+		}
+		A, err := GrB.MatrixNew[float64](6, 6)
+		try(err)
+		try(A.Build(I, J, X, nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyUndirected)
+		for _, label := range [][]int{{0, 0, 0, 3, 3, 3}, {0, 0, 2, 2, 4, 4}, {0, 0, 0, 0, 0, 0}} {
+			labels, err := GrB.VectorNew[int](6)
+			try(err)
+			try(labels.Build([]int{0, 1, 2, 3, 4, 5}, label, nil))
+			for _, resolution := range []float64{0.5, 1} {
+				modularity, err := G.Modularity(labels, false, resolution)
+				try(err)
+				if expected := referenceModularity(6, I, J, ones, label, resolution); math.Abs(modularity-expected) > 1e-9 {
+					t.Errorf("unweighted modularity %v of %v, expected %v", modularity, label, expected)
+				}
+				modularity, err = G.Modularity(labels, true, resolution)
+				try(err)
+				if expected := referenceModularity(6, I, J, X, label, resolution); math.Abs(modularity-expected) > 1e-9 {
+					t.Errorf("weighted modularity %v of %v, expected %v", modularity, label, expected)
+				}
+			}
+			try(labels.Free())
+		}
+		try(G.Delete())
+	}
+}
@@ -0,0 +1,170 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"slices"
+)
+
+// SeedTeleport returns a teleport distribution for PersonalizedPageRank that is
+// uniform over the given seed nodes.
+func SeedTeleport(n int, seeds []int) (teleport GrB.Vector[float32], err error) {
+	defer GrB.CheckErrors(&err)
+	if len(seeds) == 0 {
+		err = errors.New("no seed nodes")
+		return
+	}
+	seeds = slices.Clone(seeds)
+	slices.Sort(seeds)
+	seeds = slices.Compact(seeds)
+	values := make([]float32, len(seeds))
+	for i := range values {
+		values[i] = 1 / float32(len(seeds))
+	}
+	teleport, err = GrB.VectorNew[float32](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = teleport.Free()
+		}
+	}()
+	GrB.OK(teleport.Build(seeds, values, nil))
+	return
+}
+
+// PersonalizedPageRank computes the PageRank of G, where random jumps go to the nodes
+// in proportion to the non-negative values of teleport instead of uniformly to all
+// nodes. teleport is normalized to sum up to 1, and its missing entries are 0. Like
+// PageRank, the rank of sink nodes is redistributed according to teleport.
+func (G *Graph[D]) PersonalizedPageRank(teleport GrB.Vector[float32], damping, tolerance float32, iterMax int) (centrality GrB.Vector[float32], iterations int, err error) {
+	return G.personalizedPageRank(teleport, damping, tolerance, iterMax, false)
+}
+
+// PersonalizedPageRankGAP is PersonalizedPageRank without redistributing the rank of
+// sink nodes, like PageRankGAP. Also like PageRankGAP, it stops after iterMax
+// iterations even if it has not converged, whereas PersonalizedPageRank returns
+// ConvergenceFailure in that case.
+func (G *Graph[D]) PersonalizedPageRankGAP(teleport GrB.Vector[float32], damping, tolerance float32, iterMax int) (centrality GrB.Vector[float32], iterations int, err error) {
+	return G.personalizedPageRank(teleport, damping, tolerance, iterMax, true)
+}
+
+func (G *Graph[D]) personalizedPageRank(teleport GrB.Vector[float32], damping, tolerance float32, iterMax int, gap bool) (centrality GrB.Vector[float32], iterations int, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	GrB.OK(G.Check())
+	var AT GrB.Matrix[D]
+	if G.Kind == AdjacencyUndirected || G.IsSymmetricStructure == True {
+		AT = G.A
+	} else {
+		AT = G.AT
+		if !AT.Valid() {
+			err = errors.New("G.AT is required")
+			return
+		}
+	}
+	dOut := G.OutDegree
+	if !dOut.Valid() {
+		err = errors.New("G.OutDegree is required")
+		return
+	}
+	n, err := AT.Nrows()
+	GrB.OK(err)
+	size, err := teleport.Size()
+	GrB.OK(err)
+	if size != n {
+		err = errors.New("teleport must have one entry per node")
+		return
+	}
+	tmin, err := GrB.VectorReduce(GrB.MinMonoid[float32](), teleport, nil)
+	GrB.OK(err)
+	tsum, err := GrB.VectorReduce(GrB.PlusMonoid[float32](), teleport, nil)
+	GrB.OK(err)
+	if tmin < 0 || !(tsum > 0) {
+		err = errors.New("teleport must be non-negative with a positive sum")
+		return
+	}
+	rdiff := float32(1)
+
+	// p is the normalized teleport distribution
+	p, err := GrB.VectorNew[float32](n)
+	GrB.OK(err)
+	defer try(p.Free)
+	GrB.OK(GrB.VectorApplyBinaryOp2nd(p, nil, nil, GrB.Div[float32](), teleport, tsum, nil))
+
+	t, err := GrB.VectorNew[float32](n)
+	GrB.OK(err)
+	defer func() {
+		try(t.Free)
+	}()
+	r, err := GrB.VectorNew[float32](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = r.Free()
+		}
+	}()
+	w, err := GrB.VectorNew[float32](n)
+	GrB.OK(err)
+	defer try(w.Free)
+	GrB.OK(GrB.VectorAssign(r, nil, nil, p, GrB.All(n), nil))
+
+	var sink GrB.Vector[bool]
+	var rsink GrB.Vector[float32]
+	if !gap {
+		nvals, e := dOut.Nvals()
+		GrB.OK(e)
+		if nvals < n {
+			sink, err = GrB.VectorNew[bool](n)
+			GrB.OK(err)
+			defer try(sink.Free)
+			GrB.OK(GrB.VectorAssignConstant(sink, dOut.AsMask(), nil, true, GrB.All(n), GrB.DescSC))
+			rsink, err = GrB.VectorNew[float32](n)
+			GrB.OK(err)
+			defer try(rsink.Free)
+		}
+	}
+	d, err := GrB.VectorNew[float32](n)
+	GrB.OK(err)
+	defer try(d.Free)
+	GrB.OK(GrB.VectorApplyBinaryOp2nd(d, nil, nil, GrB.Div[float32](), GrB.VectorView[float32, int](dOut), damping, nil))
+	dmin := 1 / damping
+	d1, err := GrB.VectorNew[float32](n)
+	GrB.OK(err)
+	defer try(d1.Free)
+	GrB.OK(GrB.VectorAssignConstant(d1, nil, nil, dmin, GrB.All(n), nil))
+	GrB.OK(GrB.VectorEWiseAddBinaryOp(d, nil, nil, GrB.Max[float32](), d1, d, nil))
+	GrB.OK(d1.Free())
+
+	for iterations = 0; rdiff > tolerance; iterations++ {
+		if iterations >= iterMax {
+			if gap {
+				break
+			}
+			err = ConvergenceFailure
+			return
+		}
+		scale := 1 - damping
+		if sink.Valid() {
+			GrB.OK(rsink.Clear())
+			GrB.OK(GrB.VectorAssign(rsink, &sink, nil, r, GrB.All(n), GrB.DescS))
+			sumRsink, e := GrB.VectorReduce(GrB.PlusMonoid[float32](), rsink, nil)
+			GrB.OK(e)
+			scale += damping * sumRsink
+		}
+		t, r = r, t
+		GrB.OK(GrB.VectorEWiseMultBinaryOp(w, nil, nil, GrB.Div[float32](), t, d, nil))
+		GrB.OK(GrB.VectorApplyBinaryOp2nd(r, nil, nil, GrB.Times[float32](), p, scale, nil))
+		plus := GrB.Plus[float32]()
+		GrB.OK(GrB.MxV(r, nil, &plus, PlusSecond[float32](), GrB.MatrixView[float32, D](AT), w, nil))
+		minus := GrB.Minus[float32]()
+		GrB.OK(GrB.VectorAssign(t, nil, &minus, r, GrB.All(n), nil))
+		GrB.OK(GrB.VectorApply(t, nil, nil, GrB.Abs[float32](), t, nil))
+		rdiff, err = GrB.VectorReduce(GrB.PlusMonoid[float32](), t, nil)
+		GrB.OK(err)
+	}
+
+	centrality = r
+	return
+}
@@ -0,0 +1,159 @@
+package LAGraph_test
+
+import (
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// referencePersonalizedPageRank iterates until the ranks change by less than 1e-12
+func referencePersonalizedPageRank(n int, I, J []int, teleport []float64, damping float64, gap bool) []float64 {
+	sum := 0.0
+	for _, x := range teleport {
+		sum += x
+	}
+	p := make([]float64, n)
+	for i, x := range teleport {
+		p[i] = x / sum
+	}
+	outDegree := make([]float64, n)
+	for _, i := range I {
+		outDegree[i]++
+	}
+	r := make([]float64, n)
+	copy(r, p)
+	for diff := 1.0; diff > 1e-12; {
+		scale := 1 - damping
+		if !gap {
+			for i := range n {
+				if outDegree[i] == 0 {
+					scale += damping * r[i]
+				}
+			}
+		}
+		next := make([]float64, n)
+		for i := range n {
+			next[i] = scale * p[i]
+		}
+		for e := range I {
+			next[J[e]] += damping * r[I[e]] / outDegree[I[e]]
+		}
+		diff = 0
+		for i := range n {
+			diff += math.Abs(next[i] - r[i])
+		}
+		r = next
+	}
+	return r
+}
+
+func TestPersonalizedPageRank(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, test := range []struct {
+		aname string
+		kind  LAGraph.Kind
+		rank  []float64
+	}{
+		{"karate.mtx", LAGraph.AdjacencyUndirected, karateRank},
+		{"west0067.mtx", LAGraph.AdjacencyDirected, west0067Rank},
+		{"ldbc-directed-example.mtx", LAGraph.AdjacencyDirected, nil},
+	} {
+		f, err := os.Open(filepath.Join("testdata", test.aname))
+		try(err)
+		A, err := MatrixMarket.Read[float32](f)
+		try(err)
+		try(f.Close())
+		n, err := A.Nrows()
+		try(err)
+		var I, J []int
+		try(A.ExtractTuples(&I, &J, nil))
+		G := LAGraph.New(A, test.kind)
+		if test.kind == LAGraph.AdjacencyDirected {
+			_, err = G.CachedAT()
+			try(err)
+		}
+		try(G.CachedOutDegree())
+
+		// a uniform teleport distribution gives the ordinary PageRank
+		uniform, err := GrB.VectorNew[float32](n)
+		try(err)
+		try(GrB.VectorAssignConstant(uniform, nil, nil, 3, GrB.All(n), nil))
+		if test.rank != nil {
+			centrality, _, err := G.PersonalizedPageRank(uniform, 0.85, 1e-4, 100)
+			try(err)
+			diff, err := prDifference(centrality, test.rank)
+			try(err)
+			if diff >= 1e-4 {
+				t.Errorf("%v: uniform teleport differs from PageRank by %v", test.aname, diff)
+			}
+			try(centrality.Free())
+		}
+
+		teleport, err := LAGraph.SeedTeleport(n, []int{0, n - 1, 0})
+		try(err)
+		weighted, err := GrB.VectorNew[float32](n)
+		try(err)
+		try(weighted.SetElement(2, 1))
+		try(weighted.SetElement(1, n/2))
+		for _, v := range []GrB.Vector[float32]{teleport, weighted, uniform} {
+			p := make([]float64, n)
+			var TI []int
+			var TX []float32
+			try(v.ExtractTuples(&TI, &TX))
+			for k, i := range TI {
+				p[i] = float64(TX[k])
+			}
+			for _, gap := range []bool{false, true} {
+				var centrality GrB.Vector[float32]
+				if gap {
+					centrality, _, err = G.PersonalizedPageRankGAP(v, 0.85, 1e-6, 1000)
+				} else {
+					centrality, _, err = G.PersonalizedPageRank(v, 0.85, 1e-6, 1000)
+				}
+				try(err)
+				expected := referencePersonalizedPageRank(n, I, J, p, 0.85, gap)
+				var CI []int
+				var CX []float32
+				try(centrality.ExtractTuples(&CI, &CX))
+				actual := make([]float64, n)
+				for k, i := range CI {
+					actual[i] = float64(CX[k])
+				}
+				for i := range n {
+					if math.Abs(actual[i]-expected[i]) > 1e-4 {
+						t.Errorf("%v, gap %v: rank(%v) is %v, expected %v", test.aname, gap, i, actual[i], expected[i])
+						break
+					}
+				}
+				try(centrality.Free())
+			}
+		}
+
+		if _, _, err = G.PersonalizedPageRank(teleport, 0.85, 1e-4, 1); err != LAGraph.ConvergenceFailure {
+			t.Errorf("%v: expected a convergence failure", test.aname)
+		}
+		centrality, iterations, err := G.PersonalizedPageRankGAP(teleport, 0.85, 1e-4, 1)
+		try(err)
+		if iterations != 1 {
+			t.Errorf("%v: GAP variant ran %v iterations, expected 1", test.aname, iterations)
+		}
+		try(centrality.Free())
+		try(weighted.SetElement(-1, 0))
+		if _, _, err = G.PersonalizedPageRank(weighted, 0.85, 1e-4, 100); err == nil {
+			t.Errorf("%v: expected an error for a negative teleport value", test.aname)
+		}
+
+		try(uniform.Free())
+		try(teleport.Free())
+		try(weighted.Free())
+		try(G.Delete())
+	}
+}
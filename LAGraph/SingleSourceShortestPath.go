@@ -22,29 +22,85 @@ func SingleSourceShortestPath[D SingleSourceShortestPathDomains](G *Graph[D], so
 		err = errors.New("invalid source node")
 		return
 	}
-	t, err := GrB.VectorNew[D](n)
+
+	negativeEdgeWeights := true
+	var x D
+	switch any(x).(type) {
+	case uint, uint32, uint64:
+		negativeEdgeWeights = false
+	}
+
+	if negativeEdgeWeights {
+		if G.EMin.Valid() && (G.EMinState == Value || G.EMinState == Bound) {
+			emin, _, e := GrB.ScalarView[float64, D](G.EMin).ExtractElement()
+			GrB.OK(e)
+			negativeEdgeWeights = emin < 0
+		}
+	}
+
+	AL, err := GrB.MatrixNew[D](n, n)
+	GrB.OK(err)
+	defer try(AL.Free)
+	GrB.OK(GrB.MatrixSelect(AL, nil, nil, GrB.Valuele[D](), A, delta, nil))
+	GrB.OK(AL.Wait(GrB.Materialize))
+
+	AH, err := GrB.MatrixNew[D](n, n)
+	GrB.OK(err)
+	defer try(AH.Free)
+	GrB.OK(GrB.MatrixSelect(AH, nil, nil, GrB.Valuegt[D](), A, delta, nil))
+	GrB.OK(AH.Wait(GrB.Materialize))
+
+	t, err := deltaStepping(AL, AH, n, []int{source}, delta, negativeEdgeWeights)
+	GrB.OK(err)
+	defer try(t.Free)
+	pathLength, err = GrB.VectorNew[D](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = pathLength.Free()
+		}
+	}()
+	GrB.OK(GrB.MatrixColExtract(pathLength, nil, nil, t, GrB.All(n), 0, GrB.DescT0))
+	return
+}
+
+// deltaStepping computes the path lengths from all sources at once, with one row
+// per source. AL and AH hold the light edges with weights up to delta and the
+// heavy edges with weights above delta. Unreachable nodes have the maximum value
+// of D as their path length.
+func deltaStepping[D SingleSourceShortestPathDomains](AL, AH GrB.Matrix[D], n int, sources []int, delta D, negativeEdgeWeights bool) (t GrB.Matrix[D], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	if delta <= 0 {
+		err = errors.New("delta must be positive")
+		return
+	}
+	ns := len(sources)
+	t, err = GrB.MatrixNew[D](ns, n)
 	GrB.OK(err)
 	defer func() {
 		if err != nil {
 			_ = t.Free()
 		}
 	}()
-	tmasked, err := GrB.VectorNew[D](n)
+	tmasked, err := GrB.MatrixNew[D](ns, n)
 	GrB.OK(err)
 	defer try(tmasked.Free)
-	tReq, err := GrB.VectorNew[D](n)
+	tReq, err := GrB.MatrixNew[D](ns, n)
 	GrB.OK(err)
 	defer try(tReq.Free)
-	Empty, err := GrB.VectorNew[bool](n)
+	Empty, err := GrB.MatrixNew[bool](ns, n)
 	GrB.OK(err)
 	defer try(Empty.Free)
-	tless, err := GrB.VectorNew[bool](n)
+	tless, err := GrB.MatrixNew[bool](ns, n)
 	GrB.OK(err)
 	defer try(tless.Free)
-	s, err := GrB.VectorNew[bool](n)
+	s, err := GrB.MatrixNew[bool](ns, n)
 	GrB.OK(err)
 	defer try(s.Free)
-	reach, err := GrB.VectorNew[bool](n)
+	reach, err := GrB.MatrixNew[bool](ns, n)
 	GrB.OK(err)
 	defer try(reach.Free)
 
@@ -56,54 +112,27 @@ func SingleSourceShortestPath[D SingleSourceShortestPathDomains](G *Graph[D], so
 	GrB.OK(reach.SetSparsityControl(GrB.Bitmap))
 
 	ne := GrB.Valuene[bool]()
-	le := GrB.Valuele[D]()
 	ge := GrB.Valuege[D]()
 	lt := GrB.Valuelt[D]()
-	gt := GrB.Valuegt[D]()
 	lessThan := GrB.Lt[D]()
 	minPlus := GrB.MinPlusSemiring[D]()
-	GrB.OK(GrB.VectorAssignConstant(t, nil, nil, GrB.Maximum[D](), GrB.All(n), nil))
-	negativeEdgeWeights := true
-	var x D
-	switch any(x).(type) {
-	case uint, uint32, uint64:
-		negativeEdgeWeights = false
+	GrB.OK(GrB.MatrixAssignConstant(t, nil, nil, GrB.Maximum[D](), GrB.All(ns), GrB.All(n), nil))
+	for k, src := range sources {
+		GrB.OK(t.SetElement(0, k, src))
+		GrB.OK(reach.SetElement(true, k, src))
+		GrB.OK(s.SetElement(true, k, src))
 	}
 
-	if negativeEdgeWeights {
-		if G.EMin.Valid() && (G.EMinState == Value || G.EMinState == Bound) {
-			emin, _, e := GrB.ScalarView[float64, D](G.EMin).ExtractElement()
-			GrB.OK(e)
-			negativeEdgeWeights = emin < 0
-		}
-	}
-
-	GrB.OK(t.SetElement(0, source))
-	GrB.OK(reach.SetElement(true, source))
-	GrB.OK(s.SetElement(true, source))
-
-	AL, err := GrB.MatrixNew[D](n, n)
-	GrB.OK(err)
-	defer try(AL.Free)
-	GrB.OK(GrB.MatrixSelect(AL, nil, nil, le, A, delta, nil))
-	GrB.OK(AL.Wait(GrB.Materialize))
-
-	AH, err := GrB.MatrixNew[D](n, n)
-	GrB.OK(err)
-	defer try(AH.Free)
-	GrB.OK(GrB.MatrixSelect(AH, nil, nil, gt, A, delta, nil))
-	GrB.OK(AH.Wait(GrB.Materialize))
-
 	for step := 0; ; step++ {
 		uBound := D(step+1) * delta
 		GrB.OK(tmasked.Clear())
-		GrB.OK(GrB.VectorAssign(tmasked, &reach, nil, t, GrB.All(n), nil))
-		GrB.OK(GrB.VectorSelect(tmasked, nil, nil, lt, tmasked, uBound, nil))
+		GrB.OK(GrB.MatrixAssign(tmasked, &reach, nil, t, GrB.All(ns), GrB.All(n), nil))
+		GrB.OK(GrB.MatrixSelect(tmasked, nil, nil, lt, tmasked, uBound, nil))
 		tmaskedNvals, e := tmasked.Nvals()
 		GrB.OK(e)
 		for tmaskedNvals > 0 {
-			GrB.OK(GrB.VxM(tReq, nil, nil, minPlus, tmasked, AL, nil))
-			GrB.OK(GrB.VectorAssignConstant(s, tmasked.AsMask(), nil, true, GrB.All(n), GrB.DescS))
+			GrB.OK(GrB.MxM(tReq, nil, nil, minPlus, tmasked, AL, nil))
+			GrB.OK(GrB.MatrixAssignConstant(s, tmasked.AsMask(), nil, true, GrB.All(ns), GrB.All(n), GrB.DescS))
 
 			tReqNvals, e := tReq.Nvals()
 			GrB.OK(e)
@@ -111,39 +140,39 @@ func SingleSourceShortestPath[D SingleSourceShortestPathDomains](G *Graph[D], so
 				break
 			}
 
-			GrB.OK(GrB.VectorEWiseMultBinaryOp(tless, nil, nil, lessThan, tReq, t, nil))
+			GrB.OK(GrB.MatrixEWiseMultBinaryOp(tless, nil, nil, lessThan, tReq, t, nil))
 
-			GrB.OK(GrB.VectorSelect(tless, nil, nil, ne, tless, false, nil))
+			GrB.OK(GrB.MatrixSelect(tless, nil, nil, ne, tless, false, nil))
 			tLessNvals, e := tless.Nvals()
 			GrB.OK(e)
 			if tLessNvals == 0 {
 				break
 			}
 
-			GrB.OK(GrB.VectorAssignConstant(reach, &tless, nil, true, GrB.All(n), GrB.DescS))
+			GrB.OK(GrB.MatrixAssignConstant(reach, &tless, nil, true, GrB.All(ns), GrB.All(n), GrB.DescS))
 
 			GrB.OK(tmasked.Clear())
-			GrB.OK(GrB.VectorSelect(tmasked, &tless, nil, lt, tReq, uBound, GrB.DescS))
+			GrB.OK(GrB.MatrixSelect(tmasked, &tless, nil, lt, tReq, uBound, GrB.DescS))
 
 			if negativeEdgeWeights {
-				GrB.OK(GrB.VectorSelect(tmasked, nil, nil, ge, tmasked, D(step)*delta, nil))
+				GrB.OK(GrB.MatrixSelect(tmasked, nil, nil, ge, tmasked, D(step)*delta, nil))
 			}
 
-			GrB.OK(GrB.VectorAssign(t, &tless, nil, tReq, GrB.All(n), GrB.DescS))
-			tmaskedNvals, err = tmasked.Nvals()
-			GrB.OK(err)
+			GrB.OK(GrB.MatrixAssign(t, &tless, nil, tReq, GrB.All(ns), GrB.All(n), GrB.DescS))
+			tmaskedNvals, e = tmasked.Nvals()
+			GrB.OK(e)
 		}
 
 		GrB.OK(tmasked.Clear())
-		GrB.OK(GrB.VectorAssign(tmasked, &s, nil, t, GrB.All(n), GrB.DescS))
+		GrB.OK(GrB.MatrixAssign(tmasked, &s, nil, t, GrB.All(ns), GrB.All(n), GrB.DescS))
 
-		GrB.OK(GrB.VxM(tReq, nil, nil, minPlus, tmasked, AH, nil))
-		GrB.OK(GrB.VectorEWiseMultBinaryOp(tless, nil, nil, lessThan, tReq, t, nil))
-		GrB.OK(GrB.VectorAssign(t, &tless, nil, tReq, GrB.All(n), nil))
+		GrB.OK(GrB.MxM(tReq, nil, nil, minPlus, tmasked, AH, nil))
+		GrB.OK(GrB.MatrixEWiseMultBinaryOp(tless, nil, nil, lessThan, tReq, t, nil))
+		GrB.OK(GrB.MatrixAssign(t, &tless, nil, tReq, GrB.All(ns), GrB.All(n), nil))
 
-		GrB.OK(GrB.VectorAssignConstant(reach, &tless, nil, true, GrB.All(n), nil))
+		GrB.OK(GrB.MatrixAssignConstant(reach, &tless, nil, true, GrB.All(ns), GrB.All(n), nil))
 
-		GrB.OK(GrB.VectorAssign(reach, &s, nil, Empty, GrB.All(n), GrB.DescS))
+		GrB.OK(GrB.MatrixAssign(reach, &s, nil, Empty, GrB.All(ns), GrB.All(n), GrB.DescS))
 		nreach, e := reach.Nvals()
 		GrB.OK(e)
 		if nreach == 0 {
@@ -151,6 +180,5 @@ func SingleSourceShortestPath[D SingleSourceShortestPathDomains](G *Graph[D], so
 		}
 		GrB.OK(s.Clear())
 	}
-	pathLength = t
 	return
 }
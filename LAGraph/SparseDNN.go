@@ -0,0 +1,67 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+)
+
+// sparseDNNYMax is the activation threshold of the GraphChallenge sparse DNN
+const sparseDNNYMax = 32
+
+// SparseDNN runs inference of the GraphChallenge sparse deep neural network.
+// Each layer k computes Y = min(ReLU(Y * W[k] + bias[k]), 32), starting with the
+// feature matrix Y0. A feature is assigned to a category if its row of the final
+// activations Y has any entries.
+func SparseDNN[D float32 | float64](W []GrB.Matrix[D], bias []D, Y0 GrB.Matrix[D]) (Y GrB.Matrix[D], categories GrB.Vector[bool], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+
+	if len(W) != len(bias) {
+		err = errors.New("number of layers and biases differ")
+		return
+	}
+
+	nfeatures, nneurons, err := Y0.Size()
+	GrB.OK(err)
+	Y, err = Y0.Dup()
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = Y.Free()
+		}
+	}()
+
+	plusTimes := GrB.PlusTimesSemiring[D]()
+	plus := GrB.Plus[D]()
+	minOp := GrB.Min[D]()
+	gt := GrB.Valuegt[D]()
+
+	for k, Wk := range W {
+		nrows, ncols, e := Wk.Size()
+		GrB.OK(e)
+		if nrows != nneurons || ncols != nneurons {
+			err = errors.New("layer dimensions do not match the features")
+			return
+		}
+		GrB.OK(GrB.MxM(Y, nil, nil, plusTimes, Y, Wk, nil))
+		GrB.OK(GrB.MatrixApplyBinaryOp2nd(Y, nil, nil, plus, Y, bias[k], nil))
+		GrB.OK(GrB.MatrixSelect(Y, nil, nil, gt, Y, 0, nil))
+		GrB.OK(GrB.MatrixApplyBinaryOp2nd(Y, nil, nil, minOp, Y, sparseDNNYMax, nil))
+	}
+
+	categories, err = GrB.VectorNew[bool](nfeatures)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = categories.Free()
+		}
+	}()
+	sum, err := GrB.VectorNew[D](nfeatures)
+	GrB.OK(err)
+	defer try(sum.Free)
+	GrB.OK(GrB.MatrixReduceBinaryOp(sum, nil, nil, plus, Y, nil))
+	GrB.OK(GrB.VectorApply(categories, nil, nil, GrB.One[bool](), GrB.VectorView[bool, D](sum), nil))
+	return
+}
@@ -0,0 +1,196 @@
+package LAGraph_test
+
+import (
+	"fmt"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestSparseDNN(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	W1, err := GrB.MatrixNew[float32](2, 2)
+	try(err)
+	try(W1.Build([]int{0, 1, 1}, []int{0, 0, 1}, []float32{1, 0.5, 1}, nil))
+	W2, err := GrB.MatrixNew[float32](2, 2)
+	try(err)
+	try(W2.Build([]int{0, 1}, []int{0, 1}, []float32{40, -5}, nil))
+	Y0, err := GrB.MatrixNew[float32](2, 2)
+	try(err)
+	try(Y0.Build([]int{0, 0, 1}, []int{0, 1, 1}, []float32{1, 2, 0.5}, nil))
+	defer func() {
+		try(W1.Free())
+		try(W2.Free())
+		try(Y0.Free())
+	}()
+
+	// feature 0: [1 2] -> [1 1] -> [40 -5] -> [32 .]
+	// feature 1: [. 0.5] -> [0.25 0.5] - 1 -> dropped
+	Y, categories, err := LAGraph.SparseDNN([]GrB.Matrix[float32]{W1, W2}, []float32{-1, 0}, Y0)
+	try(err)
+	defer func() {
+		try(Y.Free())
+		try(categories.Free())
+	}()
+
+	var I, J []int
+	var X []float32
+	try(Y.ExtractTuples(&I, &J, &X))
+	if len(X) != 1 || I[0] != 0 || J[0] != 0 || X[0] != 32 {
+		t.Errorf("unexpected activations %v %v %v", I, J, X)
+	}
+	var C []int
+	try(categories.ExtractTuples(&C, nil))
+	if len(C) != 1 || C[0] != 0 {
+		t.Errorf("unexpected categories %v", C)
+	}
+
+	if _, _, err = LAGraph.SparseDNN([]GrB.Matrix[float32]{W1, W2}, []float32{-1}, Y0); err == nil {
+		t.Error("expected an error for a missing bias")
+	}
+}
+
+type edgeWeight struct {
+	j int
+	w float64
+}
+
+// referenceSparseDNN runs the layers on each feature separately, with dense activation rows
+func referenceSparseDNN(nfeatures, nneurons int, YI, YJ []int, YX []float64, W [][][]edgeWeight, bias float64) [][]float64 {
+	Y := make([][]float64, nfeatures)
+	for f := range Y {
+		Y[f] = make([]float64, nneurons)
+	}
+	for k := range YI {
+		Y[YI[k]][YJ[k]] = YX[k]
+	}
+	next := make([]float64, nneurons)
+	present := make([]bool, nneurons)
+	for _, Wk := range W {
+		for f := range Y {
+			clear(next)
+			clear(present)
+			for i, y := range Y[f] {
+				if y == 0 {
+					continue
+				}
+				for _, e := range Wk[i] {
+					next[e.j] += y * e.w
+					present[e.j] = true
+				}
+			}
+			for j := range next {
+				y := 0.0
+				if present[j] {
+					y = min(next[j]+bias, 32)
+				}
+				Y[f][j] = max(y, 0)
+			}
+		}
+	}
+	return Y
+}
+
+func TestSparseDNNData(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	const nneurons, nlayers, bias = 1024, 30, -0.3
+	dir := filepath.Join("testdata", "dnn_data")
+	read := func(name string) GrB.Matrix[float64] {
+		f, err := os.Open(filepath.Join(dir, name))
+		try(err)
+		A, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		return A
+	}
+
+	W := make([]GrB.Matrix[float64], nlayers)
+	biases := make([]float64, nlayers)
+	rows := make([][][]edgeWeight, nlayers)
+	for k := range nlayers {
+		W[k] = read(fmt.Sprintf("n%v-l%v.mtx", nneurons, k+1))
+		biases[k] = bias
+		var I, J []int
+		var X []float64
+		try(W[k].ExtractTuples(&I, &J, &X))
+		rows[k] = make([][]edgeWeight, nneurons)
+		for e := range I {
+			rows[k][I[e]] = append(rows[k][I[e]], edgeWeight{J[e], X[e]})
+		}
+	}
+	Y0 := read(fmt.Sprintf("sparse-images-%v_subset.mtx", nneurons))
+	defer func() {
+		for _, Wk := range W {
+			try(Wk.Free())
+		}
+		try(Y0.Free())
+	}()
+	nfeatures, err := Y0.Nrows()
+	try(err)
+	var YI, YJ []int
+	var YX []float64
+	try(Y0.ExtractTuples(&YI, &YJ, &YX))
+	expected := referenceSparseDNN(nfeatures, nneurons, YI, YJ, YX, rows, bias)
+
+	Y, categories, err := LAGraph.SparseDNN(W, biases, Y0)
+	try(err)
+	defer func() {
+		try(Y.Free())
+		try(categories.Free())
+	}()
+
+	var I, J []int
+	var X []float64
+	try(Y.ExtractTuples(&I, &J, &X))
+	nexpected := 0
+	expectedCategories := make(map[int]bool)
+	for f := range expected {
+		for _, y := range expected[f] {
+			if y > 0 {
+				nexpected++
+				expectedCategories[f] = true
+			}
+		}
+	}
+	if len(X) != nexpected {
+		t.Errorf("%v activations, expected %v", len(X), nexpected)
+	}
+	for k := range X {
+		if y := expected[I[k]][J[k]]; math.Abs(X[k]-y) > 1e-9*math.Max(1, y) {
+			t.Errorf("Y(%v, %v) is %v, expected %v", I[k], J[k], X[k], y)
+			break
+		}
+	}
+	var C []int
+	try(categories.ExtractTuples(&C, nil))
+	if len(C) != len(expectedCategories) {
+		t.Errorf("%v categories, expected %v", len(C), len(expectedCategories))
+	}
+	for _, f := range C {
+		if !expectedCategories[f] {
+			t.Errorf("feature %v categorized unexpectedly", f)
+		}
+	}
+
+	TrueCategories := read(fmt.Sprintf("neuron%v-l%v-categories_subset.mtx", nneurons, nlayers))
+	var trueC []int
+	try(TrueCategories.ExtractTuples(&trueC, nil, nil))
+	try(TrueCategories.Free())
+	slices.Sort(trueC)
+	if !slices.Equal(C, trueC) {
+		t.Errorf("categories %v, expected %v", C, trueC)
+	}
+}
@@ -0,0 +1,104 @@
+package LAGraph
+
+import (
+	"github.com/intel/forGraphBLASGo/GrB"
+)
+
+// squareClusteringBlockSize is the number of rows of A*A that are computed at once.
+const squareClusteringBlockSize = 1024
+
+// SquareClustering computes the square clustering coefficient of Lind et al. of each
+// node of G. G.A must be known to be symmetric, and G.NSelfEdges is required.
+func (G *Graph[D]) SquareClustering() (coefficients GrB.Vector[float64], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	S, err := G.symmetricPattern()
+	GrB.OK(err)
+	defer try(S.Free)
+	n, err := S.Nrows()
+	GrB.OK(err)
+
+	newVector := func() GrB.Vector[float64] {
+		v, e := GrB.VectorNew[float64](n)
+		GrB.OK(e)
+		GrB.OK(GrB.VectorAssignConstant(v, nil, nil, 0, GrB.All(n), nil))
+		return v
+	}
+	plus := GrB.Plus[float64]()
+	minus := GrB.Minus[float64]()
+	times := GrB.Times[float64]()
+	plusMonoid := GrB.PlusMonoid[float64]()
+
+	d := newVector()
+	defer try(d.Free)
+	GrB.OK(GrB.MatrixReduceMonoid(d, nil, &plus, plusMonoid, S, nil))
+	Ad := newVector()
+	defer try(Ad.Free)
+	GrB.OK(GrB.MxV(Ad, nil, &plus, PlusSecond[float64](), S, d, nil))
+	// dd1 = d.*(d-1)
+	dd1 := newVector()
+	defer try(dd1.Free)
+	GrB.OK(GrB.VectorApplyBinaryOp2nd(dd1, nil, nil, minus, d, 1, nil))
+	GrB.OK(GrB.VectorEWiseMultBinaryOp(dd1, nil, nil, times, dd1, d, nil))
+
+	// P = S*S counts the paths of length 2, t2 = sum(P<S>, 2) is twice the number of
+	// triangles of each node, and squares = sum(P.^2, 2); P can have close to n^2
+	// entries, so only one block of its rows is held at a time
+	t2 := newVector()
+	defer try(t2.Free)
+	squares := newVector()
+	defer try(squares.Free)
+	for start := 0; start < n; start += squareClusteringBlockSize {
+		rows := make([]int, min(start+squareClusteringBlockSize, n)-start)
+		for k := range rows {
+			rows[k] = start + k
+		}
+		b := len(rows)
+		func() {
+			Sb, e := GrB.MatrixNew[float64](b, n)
+			GrB.OK(e)
+			defer try(Sb.Free)
+			Pb, e := GrB.MatrixNew[float64](b, n)
+			GrB.OK(e)
+			defer try(Pb.Free)
+			w, e := GrB.VectorNew[float64](b)
+			GrB.OK(e)
+			defer try(w.Free)
+			GrB.OK(GrB.MatrixExtract(Sb, nil, nil, S, rows, GrB.All(n), nil))
+			GrB.OK(GrB.MxM(Pb, nil, nil, GrB.PlusOneb[float64](), Sb, S, nil))
+			GrB.OK(GrB.MatrixAssign(Sb, Sb.AsMask(), nil, Pb, GrB.All(b), GrB.All(n), GrB.DescRS))
+			GrB.OK(GrB.MatrixReduceMonoid(w, nil, nil, plusMonoid, Sb, nil))
+			GrB.OK(GrB.VectorAssign(t2, nil, &plus, w, rows, nil))
+			GrB.OK(GrB.MatrixEWiseMultBinaryOp(Pb, nil, nil, times, Pb, Pb, nil))
+			GrB.OK(GrB.MatrixReduceMonoid(w, nil, nil, plusMonoid, Pb, nil))
+			GrB.OK(GrB.VectorAssign(squares, nil, &plus, w, rows, nil))
+		}()
+	}
+
+	// the number of 4-cycles through each node is squares = (sum(P.^2, 2) - A*d - d.*(d-1)) / 2
+	GrB.OK(GrB.VectorEWiseAddBinaryOp(squares, nil, nil, minus, squares, Ad, nil))
+	GrB.OK(GrB.VectorEWiseAddBinaryOp(squares, nil, nil, minus, squares, dd1, nil))
+	GrB.OK(GrB.VectorApplyBinaryOp2nd(squares, nil, nil, GrB.Div[float64](), squares, 2, nil))
+
+	// the number of possible 4-cycles is potential = (d-1).*(A*d) - d.*(d-1) - t2 - squares,
+	// and nodes without any keep the coefficient 0
+	potential := newVector()
+	defer try(potential.Free)
+	GrB.OK(GrB.VectorApplyBinaryOp2nd(potential, nil, nil, minus, d, 1, nil))
+	GrB.OK(GrB.VectorEWiseMultBinaryOp(potential, nil, nil, times, potential, Ad, nil))
+	GrB.OK(GrB.VectorEWiseAddBinaryOp(potential, nil, nil, minus, potential, dd1, nil))
+	GrB.OK(GrB.VectorEWiseAddBinaryOp(potential, nil, nil, minus, potential, t2, nil))
+	GrB.OK(GrB.VectorEWiseAddBinaryOp(potential, nil, nil, minus, potential, squares, nil))
+	GrB.OK(GrB.VectorSelect(potential, nil, nil, GrB.Valuegt[float64](), potential, 0, nil))
+
+	coefficients = newVector()
+	defer func() {
+		if err != nil {
+			_ = coefficients.Free()
+		}
+	}()
+	GrB.OK(GrB.VectorEWiseMultBinaryOp(coefficients, nil, &plus, GrB.Div[float64](), squares, potential, nil))
+	return
+}
@@ -0,0 +1,76 @@
+package LAGraph_test
+
+import (
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func referenceSquareClustering(n int, I, J []int) []float64 {
+	neighbors := neighborSets(n, I, J)
+	clustering := make([]float64, n)
+	for v := range n {
+		var nv []int
+		for u := range neighbors[v] {
+			nv = append(nv, u)
+		}
+		squares, potential := 0, 0
+		for a := range nv {
+			for b := a + 1; b < len(nv); b++ {
+				u, w := nv[a], nv[b]
+				q := commonNeighbors(neighbors, u, w) - 1
+				degm := q + 1
+				if neighbors[u][w] {
+					degm++
+				}
+				squares += q
+				potential += (len(neighbors[u]) - degm) + (len(neighbors[w]) - degm) + q
+			}
+		}
+		if potential > 0 {
+			clustering[v] = float64(squares) / float64(potential)
+		}
+	}
+	return clustering
+}
+
+func TestSquareClustering(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	// karate.mtx has a node of degree 1 and tree-example.mtx is a tree, so both have
+	// nodes without possible 4-cycles, whose coefficient is 0
+	for _, aname := range []string{"karate.mtx", "A.mtx", "jagmesh7.mtx", "LFAT5_two.mtx", "tree-example.mtx", "ldbc-undirected-example.mtx"} {
+		f, err := os.Open(filepath.Join("testdata", aname))
+		try(err)
+		M, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		n, err := M.Nrows()
+		try(err)
+		var I, J []int
+		try(M.ExtractTuples(&I, &J, nil))
+		try(M.Free())
+		A, err := GrB.MatrixNew[int](n, n)
+		try(err)
+		try(A.Build(I, J, make([]int, len(I)), nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyUndirected)
+
+		if _, err = G.SquareClustering(); err == nil {
+			t.Errorf("%v: expected an error without G.NSelfEdges", aname)
+		}
+		try(G.CachedNSelfEdges())
+
+		coefficients, err := G.SquareClustering()
+		try(err)
+		checkCentrality(t, aname+" square clustering", coefficients, referenceSquareClustering(n, I, J))
+		try(coefficients.Free())
+
+		try(G.Delete())
+	}
+}
@@ -0,0 +1,112 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+)
+
+// StronglyConnectedComponents labels each node with the smallest node index of its
+// strongly connected component, the same convention as ConnectedComponents.
+// G.AT is required unless G.A is known to be symmetric.
+func (G *Graph[D]) StronglyConnectedComponents() (component GrB.Vector[int], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+
+	GrB.OK(G.Check())
+	var AT GrB.Matrix[D]
+	if G.Kind == AdjacencyUndirected || (G.Kind == AdjacencyDirected && G.IsSymmetricStructure == True) {
+		AT = G.A
+	} else {
+		AT = G.AT
+		if !AT.Valid() {
+			err = errors.New("G.AT is required")
+			return
+		}
+	}
+	A := G.A
+	n, err := A.Nrows()
+	GrB.OK(err)
+
+	component, err = GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = component.Free()
+		}
+	}()
+
+	// remaining holds the color of every node that is not yet labeled
+	remaining, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(remaining.Free)
+	old, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(old.Free)
+	index, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(index.Free)
+	frontier, err := GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer try(frontier.Free)
+	t, err := GrB.VectorNew[bool](n)
+	GrB.OK(err)
+	defer try(t.Free)
+
+	GrB.OK(GrB.VectorAssignConstant(index, nil, nil, 0, GrB.All(n), nil))
+	GrB.OK(GrB.VectorApplyIndexOp(index, nil, nil, GrB.RowIndex[int, int](), index, 0, nil))
+	GrB.OK(GrB.VectorAssign(remaining, nil, nil, index, GrB.All(n), nil))
+
+	minOp := GrB.Min[int]()
+	eq := GrB.Eq[int]()
+	ne := GrB.Valuene[bool]()
+	min2nd := GrB.MinSecondSemiring[int]()
+	max2nd := GrB.MaxSecondSemiring[int]()
+	Ai := GrB.MatrixView[int, D](A)
+	ATi := GrB.MatrixView[int, D](AT)
+
+	for {
+		nremaining, e := remaining.Nvals()
+		GrB.OK(e)
+		if nremaining == 0 {
+			break
+		}
+
+		// forward: propagate the smallest index that reaches each remaining node
+		GrB.OK(GrB.VectorAssign(remaining, remaining.AsMask(), nil, index, GrB.All(n), GrB.DescS))
+		for {
+			GrB.OK(GrB.VectorAssign(old, nil, nil, remaining, GrB.All(n), nil))
+			GrB.OK(GrB.MxV(remaining, remaining.AsMask(), &minOp, min2nd, ATi, remaining, GrB.DescS))
+			GrB.OK(GrB.VectorEWiseMultBinaryOp(t, nil, nil, eq, remaining, old, nil))
+			done, e := GrB.VectorReduce(GrB.LandMonoidBool, t, nil)
+			GrB.OK(e)
+			if done {
+				break
+			}
+		}
+
+		// the roots are the nodes that keep their own index as color
+		GrB.OK(GrB.VectorEWiseMultBinaryOp(t, nil, nil, eq, remaining, index, nil))
+		GrB.OK(GrB.VectorSelect(t, nil, nil, ne, t, false, nil))
+		GrB.OK(GrB.VectorAssign(frontier, &t, nil, remaining, GrB.All(n), GrB.DescRS))
+
+		// backward: a root's component consists of the nodes of the same color that reach it
+		for {
+			nfrontier, e := frontier.Nvals()
+			GrB.OK(e)
+			if nfrontier == 0 {
+				break
+			}
+			GrB.OK(GrB.VectorAssign(component, frontier.AsMask(), nil, frontier, GrB.All(n), GrB.DescS))
+			GrB.OK(GrB.VectorApply(remaining, frontier.AsMask(), nil, GrB.Identity[int](), remaining, GrB.DescRSC))
+			// every successor of a node has a color no larger than its own, so the
+			// maximum color among the successors in the frontier identifies a match
+			GrB.OK(GrB.MxV(frontier, remaining.AsMask(), nil, max2nd, Ai, frontier, GrB.DescRS))
+			GrB.OK(GrB.VectorEWiseMultBinaryOp(t, nil, nil, eq, frontier, remaining, nil))
+			GrB.OK(GrB.VectorSelect(t, nil, nil, ne, t, false, nil))
+			GrB.OK(GrB.VectorApply(frontier, &t, nil, GrB.Identity[int](), frontier, GrB.DescRS))
+		}
+	}
+	return
+}
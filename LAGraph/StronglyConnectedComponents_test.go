@@ -0,0 +1,163 @@
+package LAGraph_test
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// referenceSCC computes strongly connected components with Tarjan's algorithm,
+// labeling each node with the smallest index in its component
+func referenceSCC(n int, I, J []int) []int {
+	adj := make([][]int, n)
+	for k := range I {
+		adj[I[k]] = append(adj[I[k]], J[k])
+	}
+	index := make([]int, n)
+	low := make([]int, n)
+	onStack := make([]bool, n)
+	for i := range index {
+		index[i] = -1
+	}
+	label := make([]int, n)
+	var stack []int
+	next := 0
+	var visit func(int)
+	visit = func(v int) {
+		index[v] = next
+		low[v] = next
+		next++
+		stack = append(stack, v)
+		onStack[v] = true
+		for _, w := range adj[v] {
+			if index[w] < 0 {
+				visit(w)
+				low[v] = min(low[v], low[w])
+			} else if onStack[w] {
+				low[v] = min(low[v], index[w])
+			}
+		}
+		if low[v] == index[v] {
+			top := len(stack)
+			for stack[top-1] != v {
+				top--
+			}
+			comp := stack[top-1:]
+			smallest := v
+			for _, w := range comp {
+				smallest = min(smallest, w)
+			}
+			for _, w := range comp {
+				label[w] = smallest
+				onStack[w] = false
+			}
+			stack = stack[:top-1]
+		}
+	}
+	for v := range n {
+		if index[v] < 0 {
+			visit(v)
+		}
+	}
+	return label
+}
+
+// referenceWCC labels each node with the smallest index in its weakly connected component
+func referenceWCC(n int, I, J []int) []int {
+	label := make([]int, n)
+	for i := range label {
+		label[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if label[i] != i {
+			label[i] = find(label[i])
+		}
+		return label[i]
+	}
+	for k := range I {
+		ri, rj := find(I[k]), find(J[k])
+		if ri < rj {
+			label[rj] = ri
+		} else if rj < ri {
+			label[ri] = rj
+		}
+	}
+	for i := range label {
+		find(i)
+	}
+	return label
+}
+
+func checkComponentLabels(component GrB.Vector[int], expected []int) (err error) {
+	defer GrB.CheckErrors(&err)
+	labels, err := checkVector(component, len(expected), -1)
+	GrB.OK(err)
+	for i := range expected {
+		if labels[i] != expected[i] {
+			return errors.New("wrong component label")
+		}
+	}
+	return
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, aname := range []string{"west0067.mtx", "ldbc-directed-example.mtx", "olm1000.mtx", "cryg2500.mtx"} {
+		f, err := os.Open(filepath.Join("testdata", aname))
+		try(err)
+		A, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		n, err := A.Nrows()
+		try(err)
+		var I, J []int
+		try(A.ExtractTuples(&I, &J, nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyDirected)
+
+		if _, err = G.StronglyConnectedComponents(); err == nil {
+			t.Error("expected an error without G.AT")
+		}
+		_, err = G.CachedAT()
+		try(err)
+
+		scc, err := G.StronglyConnectedComponents()
+		try(err)
+		try(checkComponentLabels(scc, referenceSCC(n, I, J)))
+		try(scc.Free())
+
+		wcc, err := G.WeaklyConnectedComponents()
+		try(err)
+		try(checkComponentLabels(wcc, referenceWCC(n, I, J)))
+		try(wcc.Free())
+
+		try(G.Delete())
+	}
+
+	{
+		// two cycles 0 -> 1 -> 2 -> 0 and 3 -> 4 -> 3, joined by 2 -> 3, plus the isolated node 5
+		A, err := GrB.MatrixNew[bool](6, 6)
+		try(err)
+		try(A.Build([]int{0, 1, 2, 3, 4, 2}, []int{1, 2, 0, 4, 3, 3}, []bool{true, true, true, true, true, true}, nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyDirected)
+		_, err = G.CachedAT()
+		try(err)
+		scc, err := G.StronglyConnectedComponents()
+		try(err)
+		try(checkComponentLabels(scc, []int{0, 0, 0, 3, 3, 5}))
+		try(scc.Free())
+		wcc, err := G.WeaklyConnectedComponents()
+		try(err)
+		try(checkComponentLabels(wcc, []int{0, 0, 0, 0, 0, 5}))
+		try(wcc.Free())
+		try(G.Delete())
+	}
+}
@@ -0,0 +1,89 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+)
+
+// symmetricPattern returns the pattern of G.A without self edges, with all values 1.
+func (G *Graph[D]) symmetricPattern() (S GrB.Matrix[float64], err error) {
+	defer GrB.CheckErrors(&err)
+	GrB.OK(G.Check())
+	if !(G.Kind == AdjacencyUndirected || (G.Kind == AdjacencyDirected && G.IsSymmetricStructure == True)) {
+		err = errors.New("G.A must be known to be symmetric")
+		return
+	}
+	if G.NSelfEdges == Unknown {
+		err = errors.New("G.NSelfEdges is required")
+		return
+	}
+	n, err := G.A.Nrows()
+	GrB.OK(err)
+	S, err = GrB.MatrixNew[float64](n, n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = S.Free()
+		}
+	}()
+	GrB.OK(GrB.MatrixApplyBinaryOp2nd(S, nil, nil, GrB.Oneb[float64](), GrB.MatrixView[float64, D](G.A), 0, nil))
+	if G.NSelfEdges != 0 {
+		GrB.OK(GrB.MatrixSelect(S, nil, nil, GrB.Offdiag[float64](), S, 0, nil))
+	}
+	return
+}
+
+// TriangleCentrality computes the triangle centrality of Burkhardt: with t the number
+// of triangles of each node, the centrality of v is the sum of t over its neighbors
+// that share no triangle with v, plus a third of the sum of t over v and its other
+// neighbors, divided by the number of triangles in G. It is computed as
+// (3*A*t - 2*T*t + t) / sum(t), where T is the pattern of the edges in triangles.
+// If G has no triangles, all centralities are 0. G.A must be known to be symmetric,
+// and G.NSelfEdges is required.
+func (G *Graph[D]) TriangleCentrality() (centrality GrB.Vector[float64], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	S, err := G.symmetricPattern()
+	GrB.OK(err)
+	defer try(S.Free)
+	n, err := S.Nrows()
+	GrB.OK(err)
+
+	// T<S> = S*S' counts the triangles of each edge, and y = sum(T, 2) is twice the
+	// number of triangles of each node
+	T, err := GrB.MatrixNew[float64](n, n)
+	GrB.OK(err)
+	defer try(T.Free)
+	GrB.OK(GrB.MxM(T, S.AsMask(), nil, GrB.PlusOneb[float64](), S, S, GrB.DescST1))
+	y, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer try(y.Free)
+	GrB.OK(GrB.MatrixReduceMonoid(y, nil, nil, GrB.PlusMonoid[float64](), T, nil))
+	k, err := GrB.VectorReduce(GrB.PlusMonoid[float64](), y, nil)
+	GrB.OK(err)
+
+	centrality, err = GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = centrality.Free()
+		}
+	}()
+	GrB.OK(GrB.VectorAssignConstant(centrality, nil, nil, 0, GrB.All(n), nil))
+	if k == 0 {
+		return
+	}
+
+	GrB.OK(GrB.VectorApplyBinaryOp2nd(y, nil, nil, GrB.Div[float64](), y, k, nil))
+	plus := GrB.Plus[float64]()
+	minus := GrB.Minus[float64]()
+	GrB.OK(GrB.VectorAssign(centrality, nil, &plus, y, GrB.All(n), nil))
+	GrB.OK(GrB.MatrixApply(T, nil, nil, GrB.One[float64](), T, nil))
+	GrB.OK(GrB.VectorApplyBinaryOp2nd(y, nil, nil, GrB.Times[float64](), y, 2, nil))
+	GrB.OK(GrB.MxV(centrality, nil, &minus, PlusSecond[float64](), T, y, nil))
+	GrB.OK(GrB.VectorApplyBinaryOp2nd(y, nil, nil, GrB.Times[float64](), y, 1.5, nil))
+	GrB.OK(GrB.MxV(centrality, nil, &plus, PlusSecond[float64](), S, y, nil))
+	return
+}
@@ -0,0 +1,99 @@
+package LAGraph_test
+
+import (
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// neighborSets returns the neighbors of each node, ignoring self edges
+func neighborSets(n int, I, J []int) []map[int]bool {
+	neighbors := make([]map[int]bool, n)
+	for i := range neighbors {
+		neighbors[i] = make(map[int]bool)
+	}
+	for e := range I {
+		if I[e] != J[e] {
+			neighbors[I[e]][J[e]] = true
+		}
+	}
+	return neighbors
+}
+
+func commonNeighbors(neighbors []map[int]bool, u, w int) (count int) {
+	for x := range neighbors[u] {
+		if neighbors[w][x] {
+			count++
+		}
+	}
+	return
+}
+
+func referenceTriangleCentrality(n int, I, J []int) []float64 {
+	neighbors := neighborSets(n, I, J)
+	t := make([]float64, n)
+	total := 0.0
+	for v := range n {
+		for u := range neighbors[v] {
+			t[v] += float64(commonNeighbors(neighbors, u, v))
+		}
+		t[v] /= 2
+		total += t[v]
+	}
+	total /= 3
+	centrality := make([]float64, n)
+	if total == 0 {
+		return centrality
+	}
+	for v := range n {
+		c := t[v] / 3
+		for u := range neighbors[v] {
+			if commonNeighbors(neighbors, u, v) > 0 {
+				c += t[u] / 3
+			} else {
+				c += t[u]
+			}
+		}
+		centrality[v] = c / total
+	}
+	return centrality
+}
+
+func TestTriangleCentrality(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, aname := range []string{"karate.mtx", "A.mtx", "jagmesh7.mtx", "LFAT5_two.mtx", "tree-example.mtx", "ldbc-undirected-example.mtx"} {
+		f, err := os.Open(filepath.Join("testdata", aname))
+		try(err)
+		M, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		n, err := M.Nrows()
+		try(err)
+		var I, J []int
+		try(M.ExtractTuples(&I, &J, nil))
+		try(M.Free())
+		A, err := GrB.MatrixNew[int](n, n)
+		try(err)
+		try(A.Build(I, J, make([]int, len(I)), nil))
+		G := LAGraph.New(A, LAGraph.AdjacencyUndirected)
+
+		if _, err = G.TriangleCentrality(); err == nil {
+			t.Errorf("%v: expected an error without G.NSelfEdges", aname)
+		}
+		try(G.CachedNSelfEdges())
+
+		centrality, err := G.TriangleCentrality()
+		try(err)
+		checkCentrality(t, aname+" triangle centrality", centrality, referenceTriangleCentrality(n, I, J))
+		try(centrality.Free())
+
+		try(G.Delete())
+	}
+}
@@ -107,11 +107,11 @@ func tricountPrep(A GrB.Matrix[bool], l, u bool) (L, U GrB.Matrix[bool], err err
 	return
 }
 
-func (G *Graph[D]) TriangleCountMethods(inMethod TriangleCountMethod, inPresort TriangleCountPresort) (ntriangles int, method TriangleCountMethod, presort TriangleCountPresort, err error) {
+// triangleCountPrep validates G and resolves the automatic method and presort
+// choices. If the nodes are presorted, A is the permuted matrix and perm the
+// permutation, and A must be freed by the caller.
+func (G *Graph[D]) triangleCountPrep(inMethod TriangleCountMethod, inPresort TriangleCountPresort) (A GrB.Matrix[D], perm []int, method TriangleCountMethod, presort TriangleCountPresort, err error) {
 	defer GrB.CheckErrors(&err)
-	try := func(f func() error) {
-		GrB.OK(f())
-	}
 	method = inMethod
 	presort = inPresort
 	GrB.OK(G.Check())
@@ -131,7 +131,7 @@ func (G *Graph[D]) TriangleCountMethods(inMethod TriangleCountMethod, inPresort
 	case TriangleCountSandiaLL, TriangleCountSandiaUU, TriangleCountSandiaLUT, TriangleCountSandiaULT:
 		canUsePresort = true
 	}
-	A := G.A
+	A = G.A
 	Degree := G.OutDegree
 	autosort := presort == TriangleCountAutoSort
 	if autosort && canUsePresort {
@@ -142,11 +142,6 @@ func (G *Graph[D]) TriangleCountMethods(inMethod TriangleCountMethod, inPresort
 	}
 	n, err := G.A.Nrows()
 	GrB.OK(err)
-	C, err := GrB.MatrixNew[int](n, n)
-	GrB.OK(err)
-	defer try(C.Free)
-	semiring := GrB.PlusOneb[int]()
-	monoid := GrB.PlusMonoid[int]()
 	if !canUsePresort {
 		presort = TriangleCountNoSort
 	} else if autosort {
@@ -175,14 +170,38 @@ func (G *Graph[D]) TriangleCountMethods(inMethod TriangleCountMethod, inPresort
 	}
 
 	if presort != TriangleCountNoSort {
-		P, e := G.SortByDegree(true, presort == TriangleCountAscending)
-		GrB.OK(e)
+		perm, err = G.SortByDegree(true, presort == TriangleCountAscending)
+		GrB.OK(err)
 		T, e := GrB.MatrixNew[bool](n, n)
 		GrB.OK(e)
-		defer try(T.Free)
-		GrB.OK(GrB.MatrixExtract(T, nil, nil, GrB.MatrixView[bool, D](A), P, P, nil))
+		defer func() {
+			if err != nil {
+				_ = T.Free()
+			}
+		}()
+		GrB.OK(GrB.MatrixExtract(T, nil, nil, GrB.MatrixView[bool, D](A), perm, perm, nil))
 		A = GrB.MatrixView[D, bool](T)
 	}
+	return
+}
+
+func (G *Graph[D]) TriangleCountMethods(inMethod TriangleCountMethod, inPresort TriangleCountPresort) (ntriangles int, method TriangleCountMethod, presort TriangleCountPresort, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	A, perm, method, presort, err := G.triangleCountPrep(inMethod, inPresort)
+	GrB.OK(err)
+	if perm != nil {
+		defer try(A.Free)
+	}
+	n, err := A.Nrows()
+	GrB.OK(err)
+	C, err := GrB.MatrixNew[int](n, n)
+	GrB.OK(err)
+	defer try(C.Free)
+	semiring := GrB.PlusOneb[int]()
+	monoid := GrB.PlusMonoid[int]()
 
 	switch method {
 	case TriangleCountBurkhardt:
@@ -0,0 +1,125 @@
+package LAGraph
+
+import (
+	"github.com/intel/forGraphBLASGo/GrB"
+)
+
+// EdgeTriangleCount computes the support of every edge of G, the number of
+// triangles it is part of. Edges that are not part of any triangle have no
+// entry in support. The method and presort choices are the same as for
+// TriangleCountMethods. The support of edge (i, j) counts the nodes x adjacent
+// to both i and j with x below, between, or above i and j, which the methods
+// compute with different masked products of L and U, the strictly lower and
+// upper triangular parts of A:
+//
+//   - Burkhardt: S<A> = A*A
+//   - Cohen: S<A> = L*U + U*L + L*L + U*U
+//   - Sandia LL: S<L> = L*U + L*L + U*L
+//   - Sandia UU: S<U> = L*U + U*U + U*L
+//   - Sandia LUT: S<L> = L*L' + L*U' + U*U', as dot products
+//   - Sandia ULT: S<U> = L*L' + U*L' + U*U', as dot products
+//
+// The Sandia methods only compute the support of one half of the edges, and
+// add its transpose for the other half.
+func (G *Graph[D]) EdgeTriangleCount(inMethod TriangleCountMethod, inPresort TriangleCountPresort) (support GrB.Matrix[int], method TriangleCountMethod, presort TriangleCountPresort, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	A, perm, method, presort, err := G.triangleCountPrep(inMethod, inPresort)
+	GrB.OK(err)
+	if perm != nil {
+		defer try(A.Free)
+	}
+	n, err := A.Nrows()
+	GrB.OK(err)
+
+	S, err := GrB.MatrixNew[int](n, n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = S.Free()
+		}
+	}()
+	semiring := GrB.PlusOneb[int]()
+	plus := GrB.Plus[int]()
+	Ai := GrB.MatrixView[int, D](A)
+	Ab := GrB.MatrixView[bool, D](A)
+
+	L, U, err := tricountPrep(Ab, true, true)
+	GrB.OK(err)
+	defer try(L.Free)
+	defer try(U.Free)
+	Li := GrB.MatrixView[int, bool](L)
+	Ui := GrB.MatrixView[int, bool](U)
+
+	switch method {
+	case TriangleCountBurkhardt:
+		GrB.OK(GrB.MxM(S, A.AsMask(), nil, semiring, Ai, Ai, GrB.DescS))
+	case TriangleCountCohen:
+		GrB.OK(GrB.MxM(S, A.AsMask(), nil, semiring, Li, Ui, GrB.DescS))
+		GrB.OK(GrB.MxM(S, A.AsMask(), &plus, semiring, Ui, Li, GrB.DescS))
+		GrB.OK(GrB.MxM(S, A.AsMask(), &plus, semiring, Li, Li, GrB.DescS))
+		GrB.OK(GrB.MxM(S, A.AsMask(), &plus, semiring, Ui, Ui, GrB.DescS))
+	case TriangleCountSandiaLL:
+		GrB.OK(GrB.MxM(S, L.AsMask(), nil, semiring, Li, Ui, GrB.DescS))
+		GrB.OK(GrB.MxM(S, L.AsMask(), &plus, semiring, Li, Li, GrB.DescS))
+		GrB.OK(GrB.MxM(S, L.AsMask(), &plus, semiring, Ui, Li, GrB.DescS))
+	case TriangleCountSandiaUU:
+		GrB.OK(GrB.MxM(S, U.AsMask(), nil, semiring, Li, Ui, GrB.DescS))
+		GrB.OK(GrB.MxM(S, U.AsMask(), &plus, semiring, Ui, Ui, GrB.DescS))
+		GrB.OK(GrB.MxM(S, U.AsMask(), &plus, semiring, Ui, Li, GrB.DescS))
+	case TriangleCountSandiaLUT:
+		GrB.OK(GrB.MxM(S, L.AsMask(), nil, semiring, Li, Li, GrB.DescST1))
+		GrB.OK(GrB.MxM(S, L.AsMask(), &plus, semiring, Li, Ui, GrB.DescST1))
+		GrB.OK(GrB.MxM(S, L.AsMask(), &plus, semiring, Ui, Ui, GrB.DescST1))
+	case TriangleCountSandiaULT:
+		GrB.OK(GrB.MxM(S, U.AsMask(), nil, semiring, Li, Li, GrB.DescST1))
+		GrB.OK(GrB.MxM(S, U.AsMask(), &plus, semiring, Ui, Li, GrB.DescST1))
+		GrB.OK(GrB.MxM(S, U.AsMask(), &plus, semiring, Ui, Ui, GrB.DescST1))
+	default:
+		panic("unreachable code")
+	}
+	switch method {
+	case TriangleCountSandiaLL, TriangleCountSandiaUU, TriangleCountSandiaLUT, TriangleCountSandiaULT:
+		GrB.OK(GrB.MatrixEWiseAddBinaryOp(S, nil, nil, plus, S, S, GrB.DescT1))
+	}
+
+	if perm != nil {
+		R, e := GrB.MatrixNew[int](n, n)
+		GrB.OK(e)
+		e = GrB.MatrixAssign(R, nil, nil, S, perm, perm, nil)
+		GrB.OK(S.Free())
+		S = R
+		GrB.OK(e)
+	}
+	support = S
+	return
+}
+
+// VertexTriangleCount computes the number of triangles every node of G is part of,
+// using EdgeTriangleCount with the given method and presort choices.
+func (G *Graph[D]) VertexTriangleCount(inMethod TriangleCountMethod, inPresort TriangleCountPresort) (ntriangles GrB.Vector[int], method TriangleCountMethod, presort TriangleCountPresort, err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	S, method, presort, err := G.EdgeTriangleCount(inMethod, inPresort)
+	GrB.OK(err)
+	defer try(S.Free)
+	n, err := S.Nrows()
+	GrB.OK(err)
+
+	ntriangles, err = GrB.VectorNew[int](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = ntriangles.Free()
+		}
+	}()
+	plus := GrB.Plus[int]()
+	GrB.OK(GrB.VectorAssignConstant(ntriangles, nil, nil, 0, GrB.All(n), nil))
+	GrB.OK(GrB.MatrixReduceBinaryOp(ntriangles, nil, &plus, plus, S, nil))
+	GrB.OK(GrB.VectorApplyBinaryOp2nd(ntriangles, nil, nil, GrB.Div[int](), ntriangles, 2, nil))
+	return
+}
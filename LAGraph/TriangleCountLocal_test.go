@@ -0,0 +1,107 @@
+package LAGraph_test
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"testing"
+)
+
+// referenceTriangleCounts counts the triangles of every node and every edge of a symmetric graph
+func referenceTriangleCounts(n int, I, J []int) (vertex []int, edges map[edge]int) {
+	adj := make([]map[int]bool, n)
+	for i := range adj {
+		adj[i] = make(map[int]bool)
+	}
+	for e := range I {
+		adj[I[e]][J[e]] = true
+	}
+	vertex = make([]int, n)
+	edges = make(map[edge]int)
+	for i := range n {
+		for j := range adj[i] {
+			for k := range adj[i] {
+				if adj[j][k] {
+					edges[edge{i, j}]++
+					if i < j && j < k {
+						vertex[i]++
+						vertex[j]++
+						vertex[k]++
+					}
+				}
+			}
+		}
+	}
+	return
+}
+
+func checkEdgeTriangleCount(support GrB.Matrix[int], expected map[edge]int) (err error) {
+	defer GrB.CheckErrors(&err)
+	var I, J, X []int
+	GrB.OK(support.ExtractTuples(&I, &J, &X))
+	if len(X) != len(expected) {
+		return errors.New("wrong number of edges with triangles")
+	}
+	for e := range X {
+		if expected[edge{I[e], J[e]}] != X[e] {
+			return errors.New("wrong edge triangle count")
+		}
+	}
+	return
+}
+
+func TestLocalTriangleCounts(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	G, err := setupTestTriangleCounts()
+	try(err)
+	defer func() {
+		try(G.Delete())
+	}()
+	try(G.CachedOutDegree())
+	expectedVertex, expectedEdges := referenceTriangleCounts(zacharyNumNodes, zacharyI, zacharyJ)
+
+	for _, method := range []LAGraph.TriangleCountMethod{
+		LAGraph.TriangleCountAutoMethod,
+		LAGraph.TriangleCountBurkhardt,
+		LAGraph.TriangleCountCohen,
+		LAGraph.TriangleCountSandiaLL,
+		LAGraph.TriangleCountSandiaUU,
+		LAGraph.TriangleCountSandiaLUT,
+		LAGraph.TriangleCountSandiaULT,
+	} {
+		for _, presort := range []LAGraph.TriangleCountPresort{
+			LAGraph.TriangleCountAutoSort,
+			LAGraph.TriangleCountNoSort,
+			LAGraph.TriangleCountAscending,
+			LAGraph.TriangleCountDescending,
+		} {
+			support, usedMethod, usedPresort, err := G.EdgeTriangleCount(method, presort)
+			try(err)
+			if err = checkEdgeTriangleCount(support, expectedEdges); err != nil {
+				t.Errorf("method %v, presort %v: %v", usedMethod, usedPresort, err)
+			}
+			try(support.Free())
+
+			ntriangles, _, _, err := G.VertexTriangleCount(method, presort)
+			try(err)
+			result, err := checkVector(ntriangles, zacharyNumNodes, -1)
+			try(err)
+			total := 0
+			for i := range zacharyNumNodes {
+				total += result[i]
+				if result[i] != expectedVertex[i] {
+					t.Errorf("method %v, presort %v: node %v has %v triangles, expected %v", usedMethod, usedPresort, i, result[i], expectedVertex[i])
+					break
+				}
+			}
+			if total != 3*45 {
+				t.Errorf("method %v, presort %v: triangle counts add up to %v, expected %v", usedMethod, usedPresort, total, 3*45)
+			}
+			try(ntriangles.Free())
+		}
+	}
+}
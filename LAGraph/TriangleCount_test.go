@@ -309,3 +309,53 @@ func TestTriangleCountAutosort(t *testing.T) {
 		t.Errorf("ntriangles is %v, expected 2749560", nt)
 	}
 }
+
+func TestTriangleCountIsolatedNodes(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	// the triangles 0-1-2 and 2-3-4, and the isolated nodes 5 and 6, which have no
+	// entry in G.OutDegree
+	const n = 7
+	I := []int{0, 1, 0, 2, 1, 2, 2, 3, 2, 4, 3, 4}
+	J := []int{1, 0, 2, 0, 2, 1, 3, 2, 4, 2, 4, 3}
+	A, err := GrB.MatrixNew[int](n, n)
+	try(err)
+	try(A.Build(I, J, make([]int, len(I)), nil))
+	G := LAGraph.New(A, LAGraph.AdjacencyUndirected)
+	defer func() {
+		try(G.Delete())
+	}()
+	try(G.CachedNSelfEdges())
+	try(G.CachedOutDegree())
+
+	for _, ascending := range []bool{true, false} {
+		perm, err := G.SortByDegree(true, ascending)
+		try(err)
+		if len(perm) != n {
+			t.Fatalf("SortByDegree returned %v nodes, expected %v", len(perm), n)
+		}
+		first, last := perm[0], perm[n-1]
+		if !ascending {
+			first, last = last, first
+		}
+		if (first != 5 && first != 6) || last != 2 {
+			t.Errorf("ascending %v: unexpected order %v", ascending, perm)
+		}
+	}
+
+	for _, method := range AllTriangleCountMethods {
+		for _, presort := range []LAGraph.TriangleCountPresort{
+			LAGraph.TriangleCountAscending,
+			LAGraph.TriangleCountDescending,
+		} {
+			nt, _, _, err := G.TriangleCountMethods(method, presort)
+			try(err)
+			if nt != 2 {
+				t.Errorf("method %v, presort %v: %v triangles, expected 2", method, presort, nt)
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package LAGraph
+
+import (
+	"errors"
+	"github.com/intel/forGraphBLASGo/GrB"
+)
+
+// WeightedBetweenness computes the betweenness centrality of the nodes of G from the
+// shortest paths from the given sources, using the values of G.A as edge lengths,
+// which must be positive. For each source, the path lengths are computed with
+// SingleSourceShortestPath, and the shortest-path DAG consists of the edges (u, v)
+// with pathLength(u) + A(u, v) == pathLength(v), within the tolerance of
+// AllPairsShortestPath for floating-point weights. The number of shortest paths to
+// each node is pushed forward along the DAG one layer at a time, and the
+// dependencies of the source on each node are accumulated backward one layer at a
+// time in reverse order, as in Brandes' algorithm.
+func WeightedBetweenness[D SingleSourceShortestPathDomains](G *Graph[D], sources []int, delta D) (centrality GrB.Vector[float64], err error) {
+	defer GrB.CheckErrors(&err)
+	try := func(f func() error) {
+		GrB.OK(f())
+	}
+	GrB.OK(G.Check())
+	A := G.A
+	n, err := A.Nrows()
+	GrB.OK(err)
+	nvals, err := A.Nvals()
+	GrB.OK(err)
+	if nvals > 0 {
+		emin, e := GrB.MatrixReduce(GrB.MinMonoid[D](), A, nil)
+		GrB.OK(e)
+		if emin <= 0 {
+			err = errors.New("edge weights must be positive")
+			return
+		}
+	}
+	for _, src := range sources {
+		if src < 0 || src >= n {
+			err = errors.New("invalid source node")
+			return
+		}
+	}
+
+	centrality, err = GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer func() {
+		if err != nil {
+			_ = centrality.Free()
+		}
+	}()
+	GrB.OK(GrB.VectorAssignConstant(centrality, nil, nil, 0, GrB.All(n), nil))
+
+	Dd, err := GrB.MatrixNew[D](n, n)
+	GrB.OK(err)
+	defer try(Dd.Free)
+	B, err := GrB.MatrixNew[D](n, n)
+	GrB.OK(err)
+	defer try(B.Free)
+	C, err := GrB.MatrixNew[D](n, n)
+	GrB.OK(err)
+	defer try(C.Free)
+	dag, err := GrB.MatrixNew[bool](n, n)
+	GrB.OK(err)
+	defer try(dag.Free)
+	level, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer try(level.Free)
+	indegree, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer try(indegree.Free)
+	count, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer try(count.Free)
+	sigma, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer try(sigma.Free)
+	dependency, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer try(dependency.Free)
+	next, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer try(next.Free)
+	w, err := GrB.VectorNew[float64](n)
+	GrB.OK(err)
+	defer try(w.Free)
+
+	plus := GrB.Plus[float64]()
+	minus := GrB.Minus[float64]()
+	dagf := GrB.MatrixView[float64, bool](dag)
+	ones := make([]float64, n)
+	for i := range ones {
+		ones[i] = 1
+	}
+	var layers [][]int
+	var tol D
+	for _, src := range sources {
+		// Dd is the diagonal matrix of the lengths of the paths to the reachable nodes
+		pathLength, e := SingleSourceShortestPath(G, src, delta)
+		GrB.OK(e)
+		func() {
+			defer try(pathLength.Free)
+			GrB.OK(GrB.VectorSelect(pathLength, nil, nil, GrB.Valuelt[D](), pathLength, GrB.Maximum[D](), nil))
+			GrB.OK(Dd.BuildDiag(pathLength, 0, nil))
+			tol, e = nextHopTolerance(pathLength)
+			GrB.OK(e)
+		}()
+
+		// B(u, v) = pathLength(u) + A(u, v), C(u, v) = pathLength(v) + tol, and the DAG
+		// holds the edges where B is not larger than C
+		GrB.OK(GrB.MxM(B, nil, nil, GrB.MinPlusSemiring[D](), Dd, A, nil))
+		GrB.OK(GrB.MxM(C, B.AsMask(), nil, GrB.MinSecondSemiring[D](), B, Dd, GrB.DescRS))
+		GrB.OK(GrB.MatrixApplyBinaryOp2nd(C, nil, nil, GrB.Plus[D](), C, tol, nil))
+		GrB.OK(GrB.MatrixEWiseMultBinaryOp(dag, nil, nil, GrB.Le[D](), B, C, nil))
+		GrB.OK(GrB.MatrixSelect(dag, nil, nil, GrB.Valueeq[bool](), dag, true, nil))
+
+		// sigma(v) is the number of shortest paths from src to v. As in Kahn's algorithm,
+		// a node joins the next layer once the edges from all its DAG predecessors have
+		// been followed, so sigma is final for a layer once it is reached
+		GrB.OK(GrB.MatrixReduceMonoid(indegree, nil, nil, GrB.PlusMonoid[float64](), dagf, GrB.DescT0))
+		GrB.OK(sigma.Clear())
+		GrB.OK(sigma.SetElement(1, src))
+		GrB.OK(level.Clear())
+		GrB.OK(level.SetElement(1, src))
+		layers = layers[:0]
+		for {
+			var layer []int
+			GrB.OK(level.ExtractTuples(&layer, nil))
+			if len(layer) == 0 {
+				break
+			}
+			layers = append(layers, layer)
+			GrB.OK(GrB.VectorAssign(w, level.AsMask(), nil, sigma, GrB.All(n), GrB.DescRS))
+			GrB.OK(GrB.VxM(next, nil, nil, GrB.PlusFirst[float64](), w, dagf, nil))
+			GrB.OK(GrB.VectorAssign(sigma, nil, &plus, next, GrB.All(n), nil))
+			GrB.OK(GrB.VxM(count, nil, nil, PlusOne[float64](), w, dagf, nil))
+			GrB.OK(GrB.VectorAssign(indegree, nil, &minus, count, GrB.All(n), nil))
+			GrB.OK(GrB.VectorSelect(level, count.AsMask(), nil, GrB.Valueeq[float64](), indegree, 0, GrB.DescRS))
+		}
+
+		// dependency(u) is the sum of sigma(u)/sigma(v) * (1 + dependency(v)) over the
+		// edges (u, v) of the DAG, which all lead to later layers
+		GrB.OK(dependency.Clear())
+		for k := len(layers) - 1; k > 0; k-- {
+			GrB.OK(level.Clear())
+			GrB.OK(level.Build(layers[k], ones[:len(layers[k])], nil))
+			GrB.OK(GrB.VectorAssignConstant(w, level.AsMask(), nil, 1, GrB.All(n), GrB.DescRS))
+			GrB.OK(GrB.VectorAssign(w, level.AsMask(), &plus, dependency, GrB.All(n), GrB.DescS))
+			GrB.OK(GrB.VectorEWiseMultBinaryOp(w, nil, nil, GrB.Div[float64](), w, sigma, nil))
+			GrB.OK(GrB.MxV(next, nil, nil, PlusSecond[float64](), dagf, w, nil))
+			GrB.OK(GrB.VectorEWiseMultBinaryOp(next, nil, nil, GrB.Times[float64](), next, sigma, nil))
+			GrB.OK(GrB.VectorAssign(dependency, nil, &plus, next, GrB.All(n), nil))
+		}
+
+		GrB.OK(dependency.RemoveElement(src))
+		GrB.OK(GrB.VectorAssign(centrality, nil, &plus, dependency, GrB.All(n), nil))
+	}
+	return
+}
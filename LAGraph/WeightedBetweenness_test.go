@@ -0,0 +1,129 @@
+package LAGraph_test
+
+import (
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// referenceWeightedBetweenness runs Brandes' algorithm with Dijkstra's algorithm for the shortest paths
+func referenceWeightedBetweenness(n int, I, J []int, X []float64, sources []int) []float64 {
+	centrality := make([]float64, n)
+	for _, s := range sources {
+		distance := referenceDistances(n, I, J, X, s)
+		order := make([]int, 0, n)
+		for i := range n {
+			if !math.IsInf(distance[i], 1) {
+				order = append(order, i)
+			}
+		}
+		// sort the reachable nodes by their distance from s
+		for a := 1; a < len(order); a++ {
+			for b := a; b > 0 && distance[order[b]] < distance[order[b-1]]; b-- {
+				order[b], order[b-1] = order[b-1], order[b]
+			}
+		}
+		onPath := func(e int) bool {
+			return distance[I[e]]+X[e] == distance[J[e]]
+		}
+		sigma := make([]float64, n)
+		sigma[s] = 1
+		for _, v := range order {
+			for e := range I {
+				if J[e] == v && onPath(e) {
+					sigma[v] += sigma[I[e]]
+				}
+			}
+		}
+		dependency := make([]float64, n)
+		for q := len(order) - 1; q >= 0; q-- {
+			u := order[q]
+			for e := range I {
+				if I[e] == u && onPath(e) {
+					dependency[u] += sigma[u] / sigma[J[e]] * (1 + dependency[J[e]])
+				}
+			}
+			if u != s {
+				centrality[u] += dependency[u]
+			}
+		}
+	}
+	return centrality
+}
+
+func TestWeightedBetweenness(t *testing.T) {
+	try := func(err error) {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	for _, test := range []struct {
+		aname string
+		kind  LAGraph.Kind
+		delta float64
+	}{
+		{"ldbc-directed-example.mtx", LAGraph.AdjacencyDirected, 0.5},
+		{"ldbc-undirected-example.mtx", LAGraph.AdjacencyUndirected, 0.5},
+		{"cover.mtx", LAGraph.AdjacencyDirected, 2},
+		{"karate.mtx", LAGraph.AdjacencyUndirected, 1},
+	} {
+		f, err := os.Open(filepath.Join("testdata", test.aname))
+		try(err)
+		A, err := MatrixMarket.Read[float64](f)
+		try(err)
+		try(f.Close())
+		n, err := A.Nrows()
+		try(err)
+		var I, J []int
+		var X []float64
+		try(A.ExtractTuples(&I, &J, &X))
+		G := LAGraph.New(A, test.kind)
+
+		all := make([]int, n)
+		for i := range all {
+			all[i] = i
+		}
+		for _, sources := range [][]int{all, {0, n - 1}} {
+			centrality, err := LAGraph.WeightedBetweenness(G, sources, test.delta)
+			try(err)
+			checkCentrality(t, test.aname+" weighted betweenness", centrality, referenceWeightedBetweenness(n, I, J, X, sources))
+			try(centrality.Free())
+		}
+
+		if test.aname == "karate.mtx" {
+			// with unit weights, the result is the same as that of Betweenness
+			weighted, err := LAGraph.WeightedBetweenness(G, karateSources, 1)
+			try(err)
+			unweighted, err := G.Betweenness(karateSources)
+			try(err)
+			var UI []int
+			var UX []float64
+			try(unweighted.ExtractTuples(&UI, &UX))
+			checkCentrality(t, "karate weighted betweenness", weighted, UX)
+			try(weighted.Free())
+			try(unweighted.Free())
+
+			try(G.A.SetElement(-1, 0, 1))
+			if _, err = LAGraph.WeightedBetweenness(G, karateSources, 1); err == nil {
+				t.Error("expected an error for a negative edge weight")
+			}
+		}
+		try(G.Delete())
+	}
+
+	// 0.1 + 0.2 differs from 0.3 in floating point, but both paths from 0 to 2 are
+	// shortest paths, and so are both paths from 0 to 3
+	A, err := GrB.MatrixNew[float64](4, 4)
+	try(err)
+	try(A.Build([]int{0, 1, 0, 2}, []int{1, 2, 2, 3}, []float64{0.1, 0.2, 0.3, 1}, nil))
+	G := LAGraph.New(A, LAGraph.AdjacencyDirected)
+	centrality, err := LAGraph.WeightedBetweenness(G, []int{0}, 0.25)
+	try(err)
+	checkCentrality(t, "fractional weighted betweenness", centrality, []float64{0, 1, 1, 0})
+	try(centrality.Free())
+	try(G.Delete())
+}
@@ -1,14 +1,12 @@
 package LAGraph
 
 import (
-	"encoding/binary"
 	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph/Binary"
 	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
-	"io"
 	"log"
 	"os"
 	"path/filepath"
-	"unsafe"
 )
 
 /*
@@ -50,191 +48,6 @@ func DemoInit() error {
 	return nil
 }
 
-func binread[D GrB.Number](f *os.File) (matrix GrB.Matrix[D], err error) {
-	defer GrB.CheckErrors(&err)
-
-	_, err = f.Seek(512, 0)
-	GrB.OK(err)
-
-	var fmt int32 = -999
-	var kind, typecode int32
-	var hyper float64 = -999
-	var nrows, ncols, nvec, nvals, typesize uint64
-	var nonempty int64
-	GrB.OK(binary.Read(f, binary.LittleEndian, &fmt))
-	GrB.OK(binary.Read(f, binary.LittleEndian, &kind))
-	GrB.OK(binary.Read(f, binary.LittleEndian, &hyper))
-	GrB.OK(binary.Read(f, binary.LittleEndian, &nrows))
-	GrB.OK(binary.Read(f, binary.LittleEndian, &ncols))
-	GrB.OK(binary.Read(f, binary.LittleEndian, &nonempty))
-	GrB.OK(binary.Read(f, binary.LittleEndian, &nvec))
-	GrB.OK(binary.Read(f, binary.LittleEndian, &nvals))
-	GrB.OK(binary.Read(f, binary.LittleEndian, &typecode))
-	GrB.OK(binary.Read(f, binary.LittleEndian, &typesize))
-
-	iso := false
-	if kind > 100 {
-		iso = true
-		kind -= 100
-	}
-
-	isHyper := kind == 1
-	isSparse := kind == 0 || kind == int32(GrB.Sparse)
-	isBitmap := kind == int32(GrB.Bitmap)
-	isFull := kind == int32(GrB.Full)
-
-	switch typecode {
-	case 0:
-		m, e := GrB.MatrixNew[bool](int(nrows), int(ncols))
-		GrB.OK(e)
-		matrix = GrB.MatrixView[D, bool](m)
-	case 1:
-		m, e := GrB.MatrixNew[int8](int(nrows), int(ncols))
-		GrB.OK(e)
-		matrix = GrB.MatrixView[D, int8](m)
-	case 2:
-		m, e := GrB.MatrixNew[int16](int(nrows), int(ncols))
-		GrB.OK(e)
-		matrix = GrB.MatrixView[D, int16](m)
-	case 3:
-		m, e := GrB.MatrixNew[int32](int(nrows), int(ncols))
-		GrB.OK(e)
-		matrix = GrB.MatrixView[D, int32](m)
-	case 4:
-		m, e := GrB.MatrixNew[int64](int(nrows), int(ncols))
-		GrB.OK(e)
-		matrix = GrB.MatrixView[D, int64](m)
-	case 5:
-		m, e := GrB.MatrixNew[uint8](int(nrows), int(ncols))
-		GrB.OK(e)
-		matrix = GrB.MatrixView[D, uint8](m)
-	case 6:
-		m, e := GrB.MatrixNew[uint16](int(nrows), int(ncols))
-		GrB.OK(e)
-		matrix = GrB.MatrixView[D, uint16](m)
-	case 7:
-		m, e := GrB.MatrixNew[uint32](int(nrows), int(ncols))
-		GrB.OK(e)
-		matrix = GrB.MatrixView[D, uint32](m)
-	case 8:
-		m, e := GrB.MatrixNew[uint64](int(nrows), int(ncols))
-		GrB.OK(e)
-		matrix = GrB.MatrixView[D, uint64](m)
-	case 9:
-		m, e := GrB.MatrixNew[float32](int(nrows), int(ncols))
-		GrB.OK(e)
-		matrix = GrB.MatrixView[D, float32](m)
-	case 10:
-		m, e := GrB.MatrixNew[float64](int(nrows), int(ncols))
-		GrB.OK(e)
-		matrix = GrB.MatrixView[D, float64](m)
-	default:
-		err = GrB.NotImplemented
-		return
-	}
-
-	GrB.OK(matrix.SetHyperSwitch(hyper))
-
-	var ap, ai, ah, ab, ax GrB.SystemSlice[byte]
-	var axLen int
-
-	indexSize := int(unsafe.Sizeof(uint64(0)))
-	switch {
-	case isHyper:
-		apLen := int(nvec + 1)
-		ahLen := int(nvec)
-		aiLen := int(nvals)
-		axLen = int(nvals)
-		ap = GrB.MakeSystemSlice[byte](apLen * indexSize)
-		ah = GrB.MakeSystemSlice[byte](ahLen * indexSize)
-		ai = GrB.MakeSystemSlice[byte](aiLen * indexSize)
-		defer func() {
-			if err != nil {
-				ai.Free()
-				ah.Free()
-				ap.Free()
-			}
-		}()
-	case isSparse:
-		apLen := int(nvec + 1)
-		aiLen := int(nvals)
-		axLen = int(nvals)
-		ap = GrB.MakeSystemSlice[byte](apLen * indexSize)
-		ai = GrB.MakeSystemSlice[byte](aiLen * indexSize)
-		defer func() {
-			if err != nil {
-				ai.Free()
-				ap.Free()
-			}
-		}()
-	case isBitmap:
-		axLen = int(nrows * ncols)
-		ab = GrB.MakeSystemSlice[byte](int(nrows * ncols))
-		defer func() {
-			if err != nil {
-				ab.Free()
-			}
-		}()
-	case isFull:
-		axLen = int(nrows * ncols)
-	default:
-		panic("unreachable code")
-	}
-	var axSize int
-	if iso {
-		axSize = int(typesize)
-	} else {
-		axSize = axLen * int(typesize)
-	}
-	ax = GrB.MakeSystemSlice[byte](axSize)
-	defer func() {
-		if err != nil {
-			ax.Free()
-		}
-	}()
-
-	{
-		tryRead := func(bytes GrB.SystemSlice[byte]) {
-			_, err = io.ReadFull(f, bytes.UnsafeSlice())
-			GrB.OK(err)
-		}
-		switch {
-		case isHyper:
-			tryRead(ap)
-			tryRead(ah)
-			tryRead(ai)
-		case isSparse:
-			tryRead(ap)
-			tryRead(ai)
-		case isBitmap:
-			tryRead(ab)
-		}
-		tryRead(ax)
-	}
-
-	switch fmt := GrB.Layout(fmt); {
-	case fmt == GrB.ByCol && isHyper:
-		err = matrix.PackHyperCSCBytes(&ap, &ah, &ai, &ax, iso, int(nvec), false, nil)
-	case fmt == GrB.ByRow && isHyper:
-		err = matrix.PackHyperCSRBytes(&ap, &ah, &ai, &ax, iso, int(nvec), false, nil)
-	case fmt == GrB.ByCol && isSparse:
-		err = matrix.PackCSCBytes(&ap, &ai, &ax, iso, false, nil)
-	case fmt == GrB.ByRow && isSparse:
-		err = matrix.PackCSRBytes(&ap, &ai, &ax, iso, false, nil)
-	case fmt == GrB.ByCol && isBitmap:
-		err = matrix.PackBitmapCBytes(&ab, &ax, iso, int(nvals), nil)
-	case fmt == GrB.ByRow && isBitmap:
-		err = matrix.PackBitmapRBytes(&ab, &ax, iso, int(nvals), nil)
-	case fmt == GrB.ByCol && isFull:
-		err = matrix.PackFullCBytes(&ax, iso, nil)
-	case fmt == GrB.ByRow && isFull:
-		err = matrix.PackFullRBytes(&ax, iso, nil)
-	default:
-		panic("unreachable code")
-	}
-	return
-}
-
 func ReadProblem[D GrB.Number](computeSourceNodes, makeSymmetric, removeSelfEdges, structural, forceType, ensurePositive bool, args []string) (G *Graph[D], srcNodes GrB.Matrix[int], err error) {
 	defer GrB.CheckErrors(&err)
 	try := func(f func() error) {
@@ -251,13 +64,13 @@ func ReadProblem[D GrB.Number](computeSourceNodes, makeSymmetric, removeSelfEdge
 	GrB.OK(err)
 	isBinary := filepath.Ext(filename) == ".grb"
 	if isBinary {
-		RA, err = binread[int64](f)
+		RA, err = Binary.BinRead[int64](f)
 		if err != nil {
 			_ = f.Close()
 			return
 		}
 	} else {
-		RA, err = MatrixMarket.Read[int64](f)
+		RA, err = MatrixMarket.ReadParallel[int64](f)
 		if err != nil {
 			_ = f.Close()
 			return
@@ -56,4 +56,18 @@ func main() {
 		try(level.Free())
 		try(parent.Free())
 	}
+
+	sources := make([]int, ntrials)
+	for trial := range ntrials {
+		src, _, err := SourceNodes.ExtractElement(trial, 0)
+		try(err)
+		sources[trial] = src - 1
+	}
+	tmulti := time.Now()
+	levels, parents, err := G.MultiSourceBFS(sources, true, true)
+	try(err)
+	log.Printf("parent+level multi-source: %v sources duration %v\n", ntrials, time.Since(tmulti))
+
+	try(levels.Free())
+	try(parents.Free())
 }
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/intel/forGraphBLASGo/GrB"
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+func try(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+var (
+	nneurons = 1024
+	nlayers  = 30
+	bias     = -0.3
+	ntrials  = 3
+)
+
+func readMatrix[D GrB.Number](dir, name string) GrB.Matrix[D] {
+	f, err := os.Open(filepath.Join(dir, name))
+	try(err)
+	A, err := MatrixMarket.Read[D](f)
+	try(err)
+	try(f.Close())
+	return A
+}
+
+func main() {
+	flag.IntVar(&nneurons, "nneurons", 1024, "number of neurons per layer")
+	flag.IntVar(&nlayers, "nlayers", 30, "number of layers")
+	flag.Float64Var(&bias, "bias", -0.3, "bias of each layer")
+	flag.IntVar(&ntrials, "ntrials", 3, "number of trials")
+	flag.Parse()
+	if !run() {
+		os.Exit(1)
+	}
+}
+
+// run returns false if the computed categories differ from the true categories
+func run() (ok bool) {
+	ok = true
+	try(LAGraph.DemoInit())
+	defer func() {
+		try(LAGraph.Finalize())
+	}()
+
+	dir := "testdata/dnn_data"
+	if len(flag.Args()) > 0 {
+		dir = flag.Args()[0]
+	}
+
+	// the true categories depend on the number of layers; the test data ships
+	// the layers and categories for 30 layers
+	categoriesName := fmt.Sprintf("neuron%v-l%v-categories_subset.mtx", nneurons, nlayers)
+	for _, name := range []string{categoriesName, fmt.Sprintf("n%v-l%v.mtx", nneurons, nlayers)} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			log.Printf("cannot run %v layers: %v\n", nlayers, err)
+			return false
+		}
+	}
+
+	tt := time.Now()
+	W := make([]GrB.Matrix[float32], nlayers)
+	biases := make([]float32, nlayers)
+	for k := range nlayers {
+		W[k] = readMatrix[float32](dir, fmt.Sprintf("n%v-l%v.mtx", nneurons, k+1))
+		biases[k] = float32(bias)
+	}
+	defer func() {
+		for _, Wk := range W {
+			try(Wk.Free())
+		}
+	}()
+	Y0 := readMatrix[float32](dir, fmt.Sprintf("sparse-images-%v_subset.mtx", nneurons))
+	defer func() {
+		try(Y0.Free())
+	}()
+	var expected []int
+	TrueCategories := readMatrix[float32](dir, categoriesName)
+	try(TrueCategories.ExtractTuples(&expected, nil, nil))
+	try(TrueCategories.Free())
+	slices.Sort(expected)
+	log.Printf("read time: %v\n", time.Since(tt))
+
+	for trial := range ntrials {
+		tt = time.Now()
+		Y, categories, err := LAGraph.SparseDNN(W, biases, Y0)
+		try(err)
+		log.Printf("trial %v: %v layers duration: %v\n", trial, nlayers, time.Since(tt))
+
+		var result []int
+		try(categories.ExtractTuples(&result, nil))
+		if slices.Equal(result, expected) {
+			log.Printf("categories ok: %v of %v features\n", len(result), len(expected))
+		} else {
+			log.Printf("categories wrong: %v found, %v expected\n", len(result), len(expected))
+			ok = false
+		}
+
+		try(Y.Free())
+		try(categories.Free())
+	}
+	return
+}
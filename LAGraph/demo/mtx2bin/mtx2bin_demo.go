@@ -0,0 +1,44 @@
+package main
+
+import (
+	"github.com/intel/forLAGraphGo/LAGraph"
+	"github.com/intel/forLAGraphGo/LAGraph/Binary"
+	"github.com/intel/forLAGraphGo/LAGraph/MatrixMarket"
+	"log"
+	"os"
+	"time"
+)
+
+func try(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func main() {
+	try(LAGraph.DemoInit())
+	defer func() {
+		try(LAGraph.Finalize())
+	}()
+	if len(os.Args) != 3 {
+		log.Fatalln("Usage: mtx2bin infile.mtx outfile.grb")
+	}
+
+	tt := time.Now()
+	f, err := os.Open(os.Args[1])
+	try(err)
+	A, err := MatrixMarket.Read[float64](f)
+	try(err)
+	try(f.Close())
+	defer func() {
+		try(A.Free())
+	}()
+	log.Printf("read time: %v\n", time.Since(tt))
+
+	tt = time.Now()
+	f, err = os.Create(os.Args[2])
+	try(err)
+	try(Binary.BinWrite(f, A))
+	try(f.Close())
+	log.Printf("write time: %v\n", time.Since(tt))
+}
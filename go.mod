@@ -5,4 +5,5 @@ go 1.22
 require (
 	github.com/intel/forGoParallel v0.0.0-20230817114341-0dbce1d46778
 	github.com/intel/forGraphBLASGo v0.0.0-20230915115955-5dbd018b8163
+	github.com/klauspost/compress v1.18.0
 )